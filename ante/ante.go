@@ -1,54 +1,90 @@
 package ante
 
 import (
-	"fmt"
-	"log"
-
-	txsigning "cosmossdk.io/x/tx/signing"
+	errorsmod "cosmossdk.io/errors"
+	circuitante "cosmossdk.io/x/circuit/ante"
 
+	txpolicykeeper "veranatest/x/txpolicy/keeper"
 	validatorregistrykeeper "veranatest/x/validatorregistry/keeper"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
-	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
-	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	groupkeeper "github.com/cosmos/cosmos-sdk/x/group/keeper"
 )
 
-// NewAnteHandler returns an AnteHandler that includes validator whitelist checking and group proposal timing
-// It follows the standard Cosmos SDK pattern of injecting module keepers as dependencies
-func NewAnteHandler(
-	accountKeeper authkeeper.AccountKeeper,
-	bankKeeper bankkeeper.Keeper,
-	signModeHandler *txsigning.HandlerMap,
-	sigGasConsumer ante.SignatureVerificationGasConsumer,
-	validatorRegistryKeeper validatorregistrykeeper.Keeper,
-	groupKeeper groupkeeper.Keeper,
-) (sdk.AnteHandler, error) {
+// HandlerOptions extends the SDK's AnteHandler options with the keepers required
+// by the chain-specific decorators.
+type HandlerOptions struct {
+	ante.HandlerOptions
+
+	Codec                   codec.Codec
+	CircuitKeeper           circuitante.CircuitBreaker
+	ValidatorRegistryKeeper validatorregistrykeeper.Keeper
+	GroupKeeper             groupkeeper.Keeper
+	TxPolicyKeeper          txpolicykeeper.Keeper
+}
 
-	if bankKeeper == nil {
-		return nil, fmt.Errorf("bank keeper is required")
+// NewAnteHandler returns an AnteHandler that runs the full Cosmos SDK decorator
+// chain (sequence and signature checks, fee deduction, gas accounting) together
+// with the circuit breaker, circuit protection, message type policy, validator
+// whitelist and group proposal timing checks.
+//
+// The chain-specific decorators run after all stateless checks and before any
+// fee is deducted, so policy violations are rejected before the expensive
+// signature verification takes place.
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	if options.AccountKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
+	}
+	if options.BankKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
+	}
+	if options.SignModeHandler == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
-	if signModeHandler == nil {
-		return nil, fmt.Errorf("sign mode handler is required")
+	if options.Codec == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "codec is required for ante builder")
+	}
+	if options.CircuitKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "circuit keeper is required for ante builder")
+	}
+	if options.TxFeeChecker == nil {
+		options.TxFeeChecker = NewTxFeeChecker(options.TxPolicyKeeper, options.GroupKeeper, options.Codec).CheckTxFee
+	}
+	if options.SigGasConsumer == nil {
+		options.SigGasConsumer = ante.DefaultSigVerificationGasConsumer
 	}
 
-	// Chain decorators in order
 	anteDecorators := []sdk.AnteDecorator{
-		ante.NewSetUpContextDecorator(),
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		circuitante.NewCircuitBreakerDecorator(options.CircuitKeeper),
+		// Circuit protection check - the council cannot trip the messages it needs to reset a circuit
+		NewCircuitProtectionDecorator(options.Codec),
+		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		ante.NewValidateBasicDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(options.AccountKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+
+		// Message type allow/deny list check - uses txpolicy params set by the council
+		NewMsgTypePolicyDecorator(options.TxPolicyKeeper, options.Codec),
 
 		// Group proposal timing check - ensures proposals are executed only after voting period ends
-		NewGroupProposalTimingDecorator(groupKeeper),
+		NewGroupProposalTimingDecorator(options.GroupKeeper, options.Codec),
 
 		// Validator whitelist check - uses validatorregistry keeper to check KV store
-		NewValidatorWhitelistDecorator(validatorRegistryKeeper),
+		NewValidatorWhitelistDecorator(options.ValidatorRegistryKeeper, options.Codec),
 
-		// Standard Cosmos SDK decorators
-		ante.NewDeductFeeDecorator(accountKeeper, bankKeeper, nil, nil),
-		ante.NewIncrementSequenceDecorator(accountKeeper),
+		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(options.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
+		// SigVerificationDecorator also handles unordered transactions when they are enabled in x/auth
+		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigVerifyOptions...),
+		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
 	}
 
-	log.Printf("DEBUG: Successfully created ante decorators with validator whitelist and group proposal timing")
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
 }
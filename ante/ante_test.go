@@ -0,0 +1,503 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	circuittypes "cosmossdk.io/x/circuit/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"veranatest/app"
+	txpolicytypes "veranatest/x/txpolicy/types"
+)
+
+func TestAnteHandlerSignatures(t *testing.T) {
+	f := setupAnteFixture(t, 2)
+	alice, bob := f.accounts[0], f.accounts[1]
+
+	newSend := func() sdk.Msg {
+		return banktypes.NewMsgSend(alice.addr, bob.addr, sdk.NewCoins(sdk.NewCoin("uvna", sdkmath.NewInt(1))))
+	}
+
+	t.Run("valid signature is accepted", func(t *testing.T) {
+		tx := f.signTx(t, f.newTxBuilder(t, newSend()), []sdk.AccAddress{alice.addr}, []cryptotypes.PrivKey{alice.priv})
+		require.NoError(t, f.runAnte(tx))
+	})
+
+	t.Run("unsigned tx is rejected", func(t *testing.T) {
+		tx := f.newTxBuilder(t, newSend()).GetTx()
+		err := f.runAnte(tx)
+		require.ErrorIs(t, err, sdkerrors.ErrNoSignatures)
+	})
+
+	t.Run("signature from a different key is rejected", func(t *testing.T) {
+		tx := f.signTx(t, f.newTxBuilder(t, newSend()), []sdk.AccAddress{alice.addr}, []cryptotypes.PrivKey{bob.priv})
+		err := f.runAnte(tx)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+	})
+
+	t.Run("forged signature bytes are rejected", func(t *testing.T) {
+		txBuilder := f.newTxBuilder(t, newSend())
+		tx := f.signTx(t, txBuilder, []sdk.AccAddress{alice.addr}, []cryptotypes.PrivKey{alice.priv})
+
+		sigs, err := tx.(interface {
+			GetSignaturesV2() ([]signing.SignatureV2, error)
+		}).GetSignaturesV2()
+		require.NoError(t, err)
+		forger := secp256k1.GenPrivKey()
+		forged, err := forger.Sign([]byte("not the sign bytes"))
+		require.NoError(t, err)
+		sigs[0].Data = &signing.SingleSignatureData{
+			SignMode:  sigs[0].Data.(*signing.SingleSignatureData).SignMode,
+			Signature: forged,
+		}
+		require.NoError(t, txBuilder.SetSignatures(sigs...))
+
+		err = f.runAnte(txBuilder.GetTx())
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+
+	t.Run("missing signer signature is rejected", func(t *testing.T) {
+		// bob is a required signer of the second message but never signs
+		bobSend := banktypes.NewMsgSend(bob.addr, alice.addr, sdk.NewCoins(sdk.NewCoin("uvna", sdkmath.NewInt(1))))
+		tx := f.signTx(t, f.newTxBuilder(t, newSend(), bobSend), []sdk.AccAddress{alice.addr}, []cryptotypes.PrivKey{alice.priv})
+		err := f.runAnte(tx)
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+
+	t.Run("wrong sequence is rejected", func(t *testing.T) {
+		txBuilder := f.newTxBuilder(t, newSend())
+		tx := f.signTx(t, txBuilder, []sdk.AccAddress{alice.addr}, []cryptotypes.PrivKey{alice.priv})
+
+		sigs, err := tx.(interface {
+			GetSignaturesV2() ([]signing.SignatureV2, error)
+		}).GetSignaturesV2()
+		require.NoError(t, err)
+		sigs[0].Sequence++
+		require.NoError(t, txBuilder.SetSignatures(sigs...))
+
+		err = f.runAnte(txBuilder.GetTx())
+		require.ErrorIs(t, err, sdkerrors.ErrWrongSequence)
+	})
+}
+
+func TestAnteHandlerDeductsFees(t *testing.T) {
+	f := setupAnteFixture(t, 2)
+	alice, bob := f.accounts[0], f.accounts[1]
+
+	msg := banktypes.NewMsgSend(alice.addr, bob.addr, sdk.NewCoins(sdk.NewCoin("uvna", sdkmath.NewInt(1))))
+	tx := f.signTx(t, f.newTxBuilder(t, msg), []sdk.AccAddress{alice.addr}, []cryptotypes.PrivKey{alice.priv})
+
+	before := f.app.BankKeeper.GetBalance(f.ctx, alice.addr, "uvna")
+	_, err := f.app.AnteHandler()(f.ctx, tx, false)
+	require.NoError(t, err)
+	after := f.app.BankKeeper.GetBalance(f.ctx, alice.addr, "uvna")
+
+	require.Equal(t, sdkmath.NewInt(200_000), before.Amount.Sub(after.Amount))
+	require.Equal(t, uint64(1), f.app.AuthKeeper.GetAccount(f.ctx, alice.addr).GetSequence())
+}
+
+func TestAnteHandlerNestedMessages(t *testing.T) {
+	f := setupAnteFixture(t, 2)
+	alice := f.accounts[0]
+
+	createVal, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(alice.addr).String(),
+		alice.priv.PubKey(),
+		sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1_000_000)),
+		stakingtypes.NewDescription("alice", "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdkmath.LegacyNewDecWithPrec(1, 1), sdkmath.LegacyNewDecWithPrec(2, 1), sdkmath.LegacyNewDecWithPrec(1, 2)),
+		sdkmath.OneInt(),
+	)
+	require.NoError(t, err)
+
+	groupExec := &group.MsgExec{ProposalId: 42, Executor: alice.addr.String()}
+
+	testCases := []struct {
+		name   string
+		msg    sdk.Msg
+		expErr error
+	}{
+		{name: "create validator", msg: createVal, expErr: sdkerrors.ErrUnauthorized},
+		{name: "create validator inside authz exec", msg: wrapInAuthzExec(alice.addr, createVal, 1), expErr: sdkerrors.ErrUnauthorized},
+		{name: "create validator inside nested authz exec", msg: wrapInAuthzExec(alice.addr, createVal, 3), expErr: sdkerrors.ErrUnauthorized},
+		{name: "group exec", msg: groupExec, expErr: sdkerrors.ErrInvalidRequest},
+		{name: "group exec inside authz exec", msg: wrapInAuthzExec(alice.addr, groupExec, 2), expErr: sdkerrors.ErrInvalidRequest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx := f.signTx(t, f.newTxBuilder(t, tc.msg), []sdk.AccAddress{alice.addr}, []cryptotypes.PrivKey{alice.priv})
+			err := f.runAnte(tx)
+			require.ErrorIs(t, err, tc.expErr)
+		})
+	}
+}
+
+// wrapInAuthzExec wraps msg in the given number of authz MsgExec layers.
+func wrapInAuthzExec(grantee sdk.AccAddress, msg sdk.Msg, layers int) sdk.Msg {
+	for i := 0; i < layers; i++ {
+		exec := authz.NewMsgExec(grantee, []sdk.Msg{msg})
+		msg = &exec
+	}
+	return msg
+}
+
+func TestAnteHandlerValidatorWhitelist(t *testing.T) {
+	f := setupAnteFixture(t, 2)
+	alice := f.accounts[0]
+
+	validators, err := f.app.StakingKeeper.GetAllValidators(f.ctx)
+	require.NoError(t, err)
+	require.Len(t, validators, 1)
+	// the genesis validator is whitelisted, the operator of alice is not
+	whitelisted := validators[0].OperatorAddress
+	notWhitelisted := sdk.ValAddress(alice.addr).String()
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1_000_000))
+
+	testCases := []struct {
+		name   string
+		msg    sdk.Msg
+		expErr error
+	}{
+		{name: "delegate", msg: stakingtypes.NewMsgDelegate(alice.addr.String(), whitelisted, amount)},
+		{name: "delegate to a validator not whitelisted", msg: stakingtypes.NewMsgDelegate(alice.addr.String(), notWhitelisted, amount), expErr: sdkerrors.ErrUnauthorized},
+		{
+			name:   "delegate to a validator not whitelisted inside authz exec",
+			msg:    wrapInAuthzExec(alice.addr, stakingtypes.NewMsgDelegate(alice.addr.String(), notWhitelisted, amount), 1),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{name: "redelegate from a validator not whitelisted", msg: stakingtypes.NewMsgBeginRedelegate(alice.addr.String(), notWhitelisted, whitelisted, amount)},
+		{name: "redelegate to a validator not whitelisted", msg: stakingtypes.NewMsgBeginRedelegate(alice.addr.String(), whitelisted, notWhitelisted, amount), expErr: sdkerrors.ErrUnauthorized},
+		{name: "undelegate from a validator not whitelisted", msg: stakingtypes.NewMsgUndelegate(alice.addr.String(), notWhitelisted, amount)},
+		{
+			name:   "cancel unbonding from a validator not whitelisted",
+			msg:    stakingtypes.NewMsgCancelUnbondingDelegation(alice.addr.String(), notWhitelisted, 1, amount),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{name: "unjail a validator not whitelisted", msg: slashingtypes.NewMsgUnjail(notWhitelisted), expErr: sdkerrors.ErrUnauthorized},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx := f.signTx(t, f.newTxBuilder(t, tc.msg), []sdk.AccAddress{alice.addr}, []cryptotypes.PrivKey{alice.priv})
+			err := f.runAnte(tx)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestAnteHandlerRejectsGroupTryExec(t *testing.T) {
+	f := setupAnteFixture(t, 2)
+	alice := f.accounts[0]
+
+	newProposal := func(exec group.Exec) sdk.Msg {
+		msg, err := group.NewMsgSubmitProposal(alice.addr.String(), []string{alice.addr.String()}, nil, "", exec, "title", "summary")
+		require.NoError(t, err)
+		return msg
+	}
+	newVote := func(exec group.Exec) sdk.Msg {
+		return &group.MsgVote{ProposalId: 1, Voter: alice.addr.String(), Option: group.VOTE_OPTION_YES, Exec: exec}
+	}
+
+	testCases := []struct {
+		name   string
+		msg    sdk.Msg
+		expErr error
+	}{
+		{name: "submit proposal", msg: newProposal(group.Exec_EXEC_UNSPECIFIED)},
+		{name: "submit proposal with try exec", msg: newProposal(group.Exec_EXEC_TRY), expErr: sdkerrors.ErrInvalidRequest},
+		{name: "vote", msg: newVote(group.Exec_EXEC_UNSPECIFIED)},
+		{name: "vote with try exec", msg: newVote(group.Exec_EXEC_TRY), expErr: sdkerrors.ErrInvalidRequest},
+		{name: "vote with try exec inside authz exec", msg: wrapInAuthzExec(alice.addr, newVote(group.Exec_EXEC_TRY), 1), expErr: sdkerrors.ErrInvalidRequest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx := f.signTx(t, f.newTxBuilder(t, tc.msg), []sdk.AccAddress{alice.addr}, []cryptotypes.PrivKey{alice.priv})
+			err := f.runAnte(tx)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestAnteHandlerCircuitBreaker(t *testing.T) {
+	f := setupAnteFixture(t, 2)
+	alice, bob := f.accounts[0], f.accounts[1]
+
+	require.Equal(t, sdk.MustAccAddressFromBech32(app.CouncilPolicyAddress).Bytes(), f.app.CircuitBreakerKeeper.GetAuthority())
+
+	msg := banktypes.NewMsgSend(alice.addr, bob.addr, sdk.NewCoins(sdk.NewCoin("uvna", sdkmath.NewInt(1))))
+	tx := f.signTx(t, f.newTxBuilder(t, msg), []sdk.AccAddress{alice.addr}, []cryptotypes.PrivKey{alice.priv})
+	require.NoError(t, f.runAnte(tx))
+
+	require.NoError(t, f.app.CircuitBreakerKeeper.DisableList.Set(f.ctx, sdk.MsgTypeURL(msg)))
+	require.ErrorContains(t, f.runAnte(tx), "tx type not allowed")
+
+	require.NoError(t, f.app.CircuitBreakerKeeper.DisableList.Remove(f.ctx, sdk.MsgTypeURL(msg)))
+	require.NoError(t, f.runAnte(tx))
+}
+
+func TestAnteHandlerCircuitProtection(t *testing.T) {
+	f := setupAnteFixture(t, 2)
+	alice := f.accounts[0]
+
+	newTrip := func(msgTypeURLs ...string) sdk.Msg {
+		return &circuittypes.MsgTripCircuitBreaker{Authority: alice.addr.String(), MsgTypeUrls: msgTypeURLs}
+	}
+	newProposal := func(msg sdk.Msg) sdk.Msg {
+		proposal, err := group.NewMsgSubmitProposal(app.CouncilPolicyAddress, []string{alice.addr.String()}, []sdk.Msg{msg}, "", group.Exec_EXEC_UNSPECIFIED, "title", "summary")
+		require.NoError(t, err)
+		return proposal
+	}
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	voteURL := sdk.MsgTypeURL(&group.MsgVote{})
+	resetURL := sdk.MsgTypeURL(&circuittypes.MsgResetCircuitBreaker{})
+
+	testCases := []struct {
+		name   string
+		msg    sdk.Msg
+		expErr error
+	}{
+		{name: "trip unprotected message", msg: newTrip(sendURL)},
+		{name: "trip protected message", msg: newTrip(sendURL, resetURL), expErr: sdkerrors.ErrUnauthorized},
+		{name: "trip protected message inside authz exec", msg: wrapInAuthzExec(alice.addr, newTrip(voteURL), 1), expErr: sdkerrors.ErrUnauthorized},
+		{name: "council proposal tripping unprotected message", msg: newProposal(newTrip(sendURL))},
+		{name: "council proposal tripping protected message", msg: newProposal(newTrip(voteURL)), expErr: sdkerrors.ErrUnauthorized},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx := f.signTx(t, f.newTxBuilder(t, tc.msg), []sdk.AccAddress{alice.addr}, []cryptotypes.PrivKey{alice.priv})
+			err := f.runAnte(tx)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestAnteHandlerMsgTypePolicy(t *testing.T) {
+	f := setupAnteFixture(t, 2)
+	alice, bob := f.accounts[0], f.accounts[1]
+
+	send := banktypes.NewMsgSend(alice.addr, bob.addr, sdk.NewCoins(sdk.NewCoin("uvna", sdkmath.NewInt(1))))
+	sendURL := sdk.MsgTypeURL(send)
+	execURL := sdk.MsgTypeURL(&authz.MsgExec{})
+
+	testCases := []struct {
+		name    string
+		params  txpolicytypes.Params
+		msg     sdk.Msg
+		expErr  error
+		blocked string
+	}{
+		{
+			name:   "default params allow send",
+			params: txpolicytypes.DefaultParams(),
+			msg:    send,
+		},
+		{
+			name:    "denied message",
+			params:  msgTypeParams(nil, []string{sendURL}),
+			msg:     send,
+			expErr:  txpolicytypes.ErrMsgTypeDenied,
+			blocked: sendURL,
+		},
+		{
+			name:    "denied message inside authz exec",
+			params:  msgTypeParams(nil, []string{sendURL}),
+			msg:     wrapInAuthzExec(bob.addr, send, 2),
+			expErr:  txpolicytypes.ErrMsgTypeDenied,
+			blocked: sendURL,
+		},
+		{
+			name:   "allowed message",
+			params: msgTypeParams(append([]string{sendURL}, txpolicytypes.CouncilMsgTypeURLs()...), nil),
+			msg:    send,
+		},
+		{
+			name:    "wrapper not in allow list",
+			params:  msgTypeParams(append([]string{sendURL}, txpolicytypes.CouncilMsgTypeURLs()...), nil),
+			msg:     wrapInAuthzExec(bob.addr, send, 1),
+			expErr:  txpolicytypes.ErrMsgTypeNotAllowed,
+			blocked: execURL,
+		},
+		{
+			name:   "wrapper and message in allow list",
+			params: msgTypeParams(append([]string{sendURL, execURL}, txpolicytypes.CouncilMsgTypeURLs()...), nil),
+			msg:    wrapInAuthzExec(alice.addr, send, 1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.params.Validate())
+			require.NoError(t, f.app.TxpolicyKeeper.Params.Set(f.ctx, tc.params))
+
+			tx := f.signTx(t, f.newTxBuilder(t, tc.msg), []sdk.AccAddress{alice.addr}, []cryptotypes.PrivKey{alice.priv})
+			err := f.runAnte(tx)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tc.expErr)
+			require.ErrorContains(t, err, tc.blocked)
+		})
+	}
+}
+
+func TestAnteHandlerTxFeeChecker(t *testing.T) {
+	f := setupAnteFixture(t, 2)
+	alice, bob := f.accounts[0], f.accounts[1]
+
+	send := banktypes.NewMsgSend(alice.addr, bob.addr, sdk.NewCoins(sdk.NewCoin("uvna", sdkmath.NewInt(1))))
+	sendURL := sdk.MsgTypeURL(send)
+	// the fixture pays 200000uvna for 200000 gas, a gas price of 1uvna
+	tx := f.signTx(t, f.newTxBuilder(t, send), []sdk.AccAddress{alice.addr}, []cryptotypes.PrivKey{alice.priv})
+
+	testCases := []struct {
+		name        string
+		minGasPrice string
+		multipliers []txpolicytypes.MsgGasPriceMultiplier
+		localMinGas string
+		checkTx     bool
+		expErr      error
+	}{
+		{name: "no minimum", minGasPrice: "0uvna"},
+		{name: "consensus minimum met", minGasPrice: "1uvna"},
+		{name: "consensus minimum not met", minGasPrice: "1.5uvna", expErr: sdkerrors.ErrInsufficientFee},
+		{name: "consensus minimum in another denom", minGasPrice: "0.1stake", expErr: sdkerrors.ErrInsufficientFee},
+		{
+			name:        "cheap message type",
+			minGasPrice: "1.5uvna",
+			multipliers: []txpolicytypes.MsgGasPriceMultiplier{{MsgTypeUrl: sendURL, Multiplier: sdkmath.LegacyNewDecWithPrec(5, 1)}},
+		},
+		{
+			name:        "expensive message type",
+			minGasPrice: "0.5uvna",
+			multipliers: []txpolicytypes.MsgGasPriceMultiplier{{MsgTypeUrl: sendURL, Multiplier: sdkmath.LegacyNewDec(3)}},
+			expErr:      sdkerrors.ErrInsufficientFee,
+		},
+		{name: "local minimum not met in deliver tx", minGasPrice: "1uvna", localMinGas: "2uvna"},
+		{name: "local minimum not met in check tx", minGasPrice: "1uvna", localMinGas: "2uvna", checkTx: true, expErr: sdkerrors.ErrInsufficientFee},
+		{name: "local minimum met in check tx", minGasPrice: "0.5uvna", localMinGas: "1uvna", checkTx: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := txpolicytypes.DefaultParams()
+			minGasPrice, err := sdk.ParseDecCoin(tc.minGasPrice)
+			require.NoError(t, err)
+			params.MinGasPrice = minGasPrice
+			params.MsgGasPriceMultipliers = tc.multipliers
+			require.NoError(t, params.Validate())
+			require.NoError(t, f.app.TxpolicyKeeper.Params.Set(f.ctx, params))
+
+			ctx, _ := f.ctx.CacheContext()
+			ctx = ctx.WithIsCheckTx(tc.checkTx)
+			if tc.localMinGas != "" {
+				localMinGas, err := sdk.ParseDecCoins(tc.localMinGas)
+				require.NoError(t, err)
+				ctx = ctx.WithMinGasPrices(localMinGas)
+			}
+
+			_, err = f.app.AnteHandler()(ctx, tx, false)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestAnteHandlerCouncilFeeExemption(t *testing.T) {
+	f := setupAnteFixture(t, 2)
+	alice, bob := f.accounts[0], f.accounts[1]
+
+	// alice is the only member of the council
+	createMsg, err := group.NewMsgCreateGroupWithPolicy(
+		alice.addr.String(),
+		[]group.MemberRequest{{Address: alice.addr.String(), Weight: "1"}},
+		"", "", true,
+		group.NewThresholdDecisionPolicy("1", time.Hour, 0),
+	)
+	require.NoError(t, err)
+	createResp, err := f.app.GroupKeeper.CreateGroupWithPolicy(f.ctx, createMsg)
+	require.NoError(t, err)
+	require.Equal(t, app.CouncilPolicyAddress, createResp.GroupPolicyAddress)
+
+	params := txpolicytypes.DefaultParams()
+	params.MinGasPrice = sdk.NewDecCoin("uvna", sdkmath.NewInt(1))
+	require.NoError(t, f.app.TxpolicyKeeper.Params.Set(f.ctx, params))
+
+	newProposal := func(proposer sdk.AccAddress, policy string) sdk.Msg {
+		msg, err := group.NewMsgSubmitProposal(policy, []string{proposer.String()}, nil, "", group.Exec_EXEC_UNSPECIFIED, "title", "summary")
+		require.NoError(t, err)
+		return msg
+	}
+	send := banktypes.NewMsgSend(alice.addr, bob.addr, sdk.NewCoins(sdk.NewCoin("uvna", sdkmath.NewInt(1))))
+
+	testCases := []struct {
+		name      string
+		signer    testAccount
+		msgs      []sdk.Msg
+		exemption bool
+		expErr    error
+	}{
+		{name: "council member proposal", signer: alice, msgs: []sdk.Msg{newProposal(alice.addr, app.CouncilPolicyAddress)}, exemption: true},
+		{name: "exemption disabled", signer: alice, msgs: []sdk.Msg{newProposal(alice.addr, app.CouncilPolicyAddress)}, expErr: sdkerrors.ErrInsufficientFee},
+		{name: "non member proposal", signer: bob, msgs: []sdk.Msg{newProposal(bob.addr, app.CouncilPolicyAddress)}, exemption: true, expErr: sdkerrors.ErrInsufficientFee},
+		{name: "proposal to another policy", signer: alice, msgs: []sdk.Msg{newProposal(alice.addr, bob.addr.String())}, exemption: true, expErr: sdkerrors.ErrInsufficientFee},
+		{name: "council member with other message", signer: alice, msgs: []sdk.Msg{newProposal(alice.addr, app.CouncilPolicyAddress), send}, exemption: true, expErr: sdkerrors.ErrInsufficientFee},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params.CouncilFeeExemption = tc.exemption
+			require.NoError(t, f.app.TxpolicyKeeper.Params.Set(f.ctx, params))
+
+			txBuilder := f.newTxBuilder(t, tc.msgs...)
+			txBuilder.SetFeeAmount(nil)
+			tx := f.signTx(t, txBuilder, []sdk.AccAddress{tc.signer.addr}, []cryptotypes.PrivKey{tc.signer.priv})
+
+			err := f.runAnte(tx)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tc.expErr)
+		})
+	}
+}
+
+// msgTypeParams returns the default params with the given allowed and denied
+// message type URLs.
+func msgTypeParams(allowed, denied []string) txpolicytypes.Params {
+	params := txpolicytypes.DefaultParams()
+	params.AllowedMsgTypeUrls = allowed
+	params.DeniedMsgTypeUrls = denied
+	return params
+}
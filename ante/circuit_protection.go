@@ -0,0 +1,71 @@
+package ante
+
+import (
+	"slices"
+
+	"cosmossdk.io/errors"
+	circuittypes "cosmossdk.io/x/circuit/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// ProtectedMsgTypeURLs returns the messages the council needs to reset a
+// circuit. Tripping any of them would leave the council unable to undo it.
+func ProtectedMsgTypeURLs() []string {
+	return []string{
+		sdk.MsgTypeURL(&group.MsgSubmitProposal{}),
+		sdk.MsgTypeURL(&group.MsgVote{}),
+		sdk.MsgTypeURL(&group.MsgExec{}),
+		sdk.MsgTypeURL(&circuittypes.MsgResetCircuitBreaker{}),
+	}
+}
+
+// CircuitProtectionDecorator rejects MsgTripCircuitBreaker messages disabling
+// any of the ProtectedMsgTypeURLs
+type CircuitProtectionDecorator struct {
+	walker MsgWalker
+}
+
+// NewCircuitProtectionDecorator creates a new CircuitProtectionDecorator
+func NewCircuitProtectionDecorator(cdc codec.Codec) CircuitProtectionDecorator {
+	return CircuitProtectionDecorator{
+		walker: NewMsgWalker(cdc),
+	}
+}
+
+// AnteHandle checks every MsgTripCircuitBreaker of the transaction, including
+// messages wrapped inside authz, group or ICA messages. A group proposal cannot
+// change its messages once submitted, so checking them on submission also
+// covers their later execution through MsgExec.
+func (cpd CircuitProtectionDecorator) AnteHandle(
+	ctx sdk.Context,
+	tx sdk.Tx,
+	simulate bool,
+	next sdk.AnteHandler,
+) (sdk.Context, error) {
+	protected := ProtectedMsgTypeURLs()
+
+	err := cpd.walker.Walk(tx.GetMsgs(), func(msg sdk.Msg, _ int) error {
+		tripMsg, ok := msg.(*circuittypes.MsgTripCircuitBreaker)
+		if !ok {
+			return nil
+		}
+		for _, msgTypeURL := range tripMsg.MsgTypeUrls {
+			if slices.Contains(protected, msgTypeURL) {
+				return errors.Wrapf(
+					sdkerrors.ErrUnauthorized,
+					"%s is required by the council to reset circuits and cannot be disabled",
+					msgTypeURL,
+				)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
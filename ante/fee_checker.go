@@ -0,0 +1,212 @@
+package ante
+
+import (
+	"math"
+
+	txpolicykeeper "veranatest/x/txpolicy/keeper"
+
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	groupkeeper "github.com/cosmos/cosmos-sdk/x/group/keeper"
+)
+
+// TxFeeChecker checks transaction fees against the validator's local
+// min-gas-prices during CheckTx, and against the consensus minimum gas price
+// from the txpolicy params in every mode
+type TxFeeChecker struct {
+	txPolicyKeeper txpolicykeeper.Keeper
+	groupKeeper    groupkeeper.Keeper
+	walker         MsgWalker
+}
+
+// NewTxFeeChecker creates a new TxFeeChecker
+func NewTxFeeChecker(txPolicyKeeper txpolicykeeper.Keeper, groupKeeper groupkeeper.Keeper, cdc codec.Codec) TxFeeChecker {
+	return TxFeeChecker{
+		txPolicyKeeper: txPolicyKeeper,
+		groupKeeper:    groupKeeper,
+		walker:         NewMsgWalker(cdc),
+	}
+}
+
+// CheckTxFee implements ante.TxFeeChecker. It returns the fee to deduct and the
+// priority of the transaction.
+func (c TxFeeChecker) CheckTxFee(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, 0, errors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	feeCoins := feeTx.GetFee()
+	gas := feeTx.GetGas()
+
+	params, err := c.txPolicyKeeper.Params.Get(ctx)
+	if err != nil {
+		return nil, 0, errors.Wrapf(sdkerrors.ErrLogic, "failed to get txpolicy params: %v", err)
+	}
+
+	if params.CouncilFeeExemption {
+		exempt, err := c.isCouncilTx(ctx, tx)
+		if err != nil {
+			return nil, 0, err
+		}
+		if exempt {
+			return feeCoins, getTxPriority(feeCoins, int64(gas)), nil
+		}
+	}
+
+	// Validator local minimum, only applied when the tx enters the mempool
+	if ctx.IsCheckTx() {
+		minGasPrices := ctx.MinGasPrices()
+		if !minGasPrices.IsZero() {
+			requiredFees := make(sdk.Coins, len(minGasPrices))
+
+			// Determine the required fees by multiplying each required minimum gas
+			// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
+			glDec := sdkmath.LegacyNewDec(int64(gas))
+			for i, gp := range minGasPrices {
+				fee := gp.Amount.Mul(glDec)
+				requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+			}
+
+			if !feeCoins.IsAnyGTE(requiredFees) {
+				return nil, 0, errors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+			}
+		}
+	}
+
+	// Consensus minimum, scaled by the highest multiplier of all messages
+	if !params.MinGasPrice.Amount.IsNil() && params.MinGasPrice.IsPositive() {
+		multiplier := sdkmath.LegacyZeroDec()
+		err := c.walker.Walk(tx.GetMsgs(), func(msg sdk.Msg, _ int) error {
+			multiplier = sdkmath.LegacyMaxDec(multiplier, params.GasPriceMultiplier(sdk.MsgTypeURL(msg)))
+			return nil
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+
+		gasPrice := params.MinGasPrice.Amount.Mul(multiplier)
+		required := sdk.NewCoin(params.MinGasPrice.Denom, gasPrice.MulInt64(int64(gas)).Ceil().RoundInt())
+		if feeCoins.AmountOf(required.Denom).LT(required.Amount) {
+			return nil, 0, errors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s consensus minimum: %s", feeCoins, required)
+		}
+	}
+
+	return feeCoins, getTxPriority(feeCoins, int64(gas)), nil
+}
+
+// isCouncilTx returns true if every signer of tx is a council member and every
+// message submits, votes on, executes or withdraws a council proposal.
+func (c TxFeeChecker) isCouncilTx(ctx sdk.Context, tx sdk.Tx) (bool, error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return false, nil
+	}
+
+	council := sdk.AccAddress(c.txPolicyKeeper.GetAuthority()).String()
+
+	for _, msg := range tx.GetMsgs() {
+		var policy string
+		switch m := msg.(type) {
+		case *group.MsgSubmitProposal:
+			policy = m.GroupPolicyAddress
+		case *group.MsgVote:
+			policy = c.proposalPolicy(ctx, m.ProposalId)
+		case *group.MsgExec:
+			policy = c.proposalPolicy(ctx, m.ProposalId)
+		case *group.MsgWithdrawProposal:
+			policy = c.proposalPolicy(ctx, m.ProposalId)
+		default:
+			return false, nil
+		}
+		if policy != council {
+			return false, nil
+		}
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return false, err
+	}
+	if len(signers) == 0 {
+		return false, nil
+	}
+
+	members, err := c.councilMembers(ctx, council)
+	if err != nil {
+		// the txpolicy authority is not a group policy, so there is no council
+		return false, nil
+	}
+	for _, signer := range signers {
+		if _, ok := members[sdk.AccAddress(signer).String()]; !ok {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// proposalPolicy returns the group policy address of the proposal, or an empty
+// string if the proposal does not exist
+func (c TxFeeChecker) proposalPolicy(ctx sdk.Context, proposalID uint64) string {
+	resp, err := c.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	if err != nil {
+		return ""
+	}
+
+	return resp.Proposal.GroupPolicyAddress
+}
+
+// councilMembers returns the addresses of the members of the group behind the
+// council policy
+func (c TxFeeChecker) councilMembers(ctx sdk.Context, council string) (map[string]struct{}, error) {
+	policyResp, err := c.groupKeeper.GroupPolicyInfo(ctx, &group.QueryGroupPolicyInfoRequest{Address: council})
+	if err != nil {
+		return nil, err
+	}
+
+	members := make(map[string]struct{})
+	pageReq := &query.PageRequest{}
+	for {
+		membersResp, err := c.groupKeeper.GroupMembers(ctx, &group.QueryGroupMembersRequest{
+			GroupId:    policyResp.Info.GroupId,
+			Pagination: pageReq,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range membersResp.Members {
+			members[m.Member.Address] = struct{}{}
+		}
+		if membersResp.Pagination == nil || len(membersResp.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: membersResp.Pagination.NextKey}
+	}
+
+	return members, nil
+}
+
+// getTxPriority returns a naive tx priority based on the amount of the smallest
+// denomination of the gas price provided in a transaction.
+func getTxPriority(fee sdk.Coins, gas int64) int64 {
+	var priority int64
+	for _, c := range fee {
+		p := int64(math.MaxInt64)
+		gasPrice := c.Amount.QuoRaw(gas)
+		if gasPrice.IsInt64() {
+			p = gasPrice.Int64()
+		}
+		if priority == 0 || p < priority {
+			priority = p
+		}
+	}
+
+	return priority
+}
@@ -0,0 +1,39 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tdkeeper "veranatest/x/td/keeper"
+)
+
+// FeeShareDecorator moves the td fee_share_rate fraction of the fees paid by a
+// transaction from the fee collector to the trust deposit module.
+//
+// Post handlers run on the message execution state, which is discarded when a
+// message fails, so only the fees of successful transactions are shared.
+type FeeShareDecorator struct {
+	tdKeeper tdkeeper.Keeper
+}
+
+// NewFeeShareDecorator creates a new FeeShareDecorator
+func NewFeeShareDecorator(tdKeeper tdkeeper.Keeper) FeeShareDecorator {
+	return FeeShareDecorator{
+		tdKeeper: tdKeeper,
+	}
+}
+
+// PostHandle implements sdk.PostDecorator
+func (d FeeShareDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || !success {
+		return next(ctx, tx, simulate, success)
+	}
+
+	if fees := feeTx.GetFee(); !fees.IsZero() {
+		if _, err := d.tdKeeper.ShareTxFees(ctx, fees); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate, success)
+}
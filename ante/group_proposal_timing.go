@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/group"
@@ -13,64 +14,100 @@ import (
 // GroupProposalTimingDecorator checks if group proposals are being executed after the voting period ends
 type GroupProposalTimingDecorator struct {
 	groupKeeper groupkeeper.Keeper
+	walker      MsgWalker
 }
 
 // NewGroupProposalTimingDecorator creates a new GroupProposalTimingDecorator
-func NewGroupProposalTimingDecorator(groupKeeper groupkeeper.Keeper) GroupProposalTimingDecorator {
+func NewGroupProposalTimingDecorator(groupKeeper groupkeeper.Keeper, cdc codec.Codec) GroupProposalTimingDecorator {
 	return GroupProposalTimingDecorator{
 		groupKeeper: groupKeeper,
+		walker:      NewMsgWalker(cdc),
 	}
 }
 
-// AnteHandle checks if group proposal execution happens after voting period ends
+// AnteHandle checks if group proposal execution happens after voting period ends,
+// including executions wrapped inside authz, group or ICA messages. Immediate
+// execution through Exec=EXEC_TRY on MsgSubmitProposal or MsgVote is rejected,
+// as it would run the proposal before its voting period ends.
 func (gptd GroupProposalTimingDecorator) AnteHandle(
 	ctx sdk.Context,
 	tx sdk.Tx,
 	simulate bool,
 	next sdk.AnteHandler,
 ) (sdk.Context, error) {
-	for _, msg := range tx.GetMsgs() {
-		if execMsg, ok := msg.(*group.MsgExec); ok {
-			// Get the proposal to check its voting period
-			proposalResp, err := gptd.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{
-				ProposalId: execMsg.ProposalId,
-			})
-			if err != nil {
-				return ctx, errors.Wrapf(
-					sdkerrors.ErrInvalidRequest,
-					"failed to get proposal %d: %v",
-					execMsg.ProposalId,
-					err,
-				)
-			}
+	err := gptd.walker.Walk(tx.GetMsgs(), func(msg sdk.Msg, _ int) error {
+		switch m := msg.(type) {
+		case *group.MsgExec:
+			return gptd.checkExec(ctx, m)
+		case *group.MsgSubmitProposal:
+			return checkNoTryExec(m.Exec, "MsgSubmitProposal")
+		case *group.MsgVote:
+			return checkNoTryExec(m.Exec, "MsgVote")
+		}
+		return nil
+	})
+	if err != nil {
+		return ctx, err
+	}
 
-			proposal := proposalResp.Proposal
+	return next(ctx, tx, simulate)
+}
 
-			// Check if current block time is after voting period end
-			currentTime := ctx.BlockTime()
-			votingPeriodEnd := proposal.VotingPeriodEnd
+// checkExec ensures the proposal executed by execMsg has been accepted and its
+// voting period has ended
+func (gptd GroupProposalTimingDecorator) checkExec(ctx sdk.Context, execMsg *group.MsgExec) error {
+	// Get the proposal to check its voting period
+	proposalResp, err := gptd.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{
+		ProposalId: execMsg.ProposalId,
+	})
+	if err != nil {
+		return errors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"failed to get proposal %d: %v",
+			execMsg.ProposalId,
+			err,
+		)
+	}
 
-			if currentTime.Before(votingPeriodEnd) {
-				return ctx, errors.Wrapf(
-					sdkerrors.ErrInvalidRequest,
-					"proposal %d cannot be executed yet. Voting period ends at %s, current time is %s. Execute only after voting period ends",
-					execMsg.ProposalId,
-					votingPeriodEnd.Format(time.RFC3339),
-					currentTime.Format(time.RFC3339),
-				)
-			}
+	proposal := proposalResp.Proposal
 
-			// Additional check: ensure proposal is in ACCEPTED status
-			if proposal.Status != group.PROPOSAL_STATUS_ACCEPTED {
-				return ctx, errors.Wrapf(
-					sdkerrors.ErrInvalidRequest,
-					"proposal %d is not in ACCEPTED status (current: %s). Only accepted proposals can be executed",
-					execMsg.ProposalId,
-					proposal.Status.String(),
-				)
-			}
-		}
+	// Check if current block time is after voting period end
+	currentTime := ctx.BlockTime()
+	votingPeriodEnd := proposal.VotingPeriodEnd
+
+	if currentTime.Before(votingPeriodEnd) {
+		return errors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"proposal %d cannot be executed yet. Voting period ends at %s, current time is %s. Execute only after voting period ends",
+			execMsg.ProposalId,
+			votingPeriodEnd.Format(time.RFC3339),
+			currentTime.Format(time.RFC3339),
+		)
 	}
 
-	return next(ctx, tx, simulate)
+	// Additional check: ensure proposal is in ACCEPTED status
+	if proposal.Status != group.PROPOSAL_STATUS_ACCEPTED {
+		return errors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"proposal %d is not in ACCEPTED status (current: %s). Only accepted proposals can be executed",
+			execMsg.ProposalId,
+			proposal.Status.String(),
+		)
+	}
+
+	return nil
+}
+
+// checkNoTryExec rejects EXEC_TRY on proposal submissions and votes. Accepted
+// proposals are executed automatically once their voting period ends.
+func checkNoTryExec(exec group.Exec, msgName string) error {
+	if exec == group.Exec_EXEC_TRY {
+		return errors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"%s with EXEC_TRY is not allowed. Proposals are executed automatically after the voting period ends",
+			msgName,
+		)
+	}
+
+	return nil
 }
@@ -0,0 +1,48 @@
+package ante
+
+import (
+	txpolicykeeper "veranatest/x/txpolicy/keeper"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgTypePolicyDecorator rejects transactions containing message types that are
+// denied, or not allowed, by the txpolicy module params
+type MsgTypePolicyDecorator struct {
+	txPolicyKeeper txpolicykeeper.Keeper
+	walker         MsgWalker
+}
+
+// NewMsgTypePolicyDecorator creates a new MsgTypePolicyDecorator
+func NewMsgTypePolicyDecorator(txPolicyKeeper txpolicykeeper.Keeper, cdc codec.Codec) MsgTypePolicyDecorator {
+	return MsgTypePolicyDecorator{
+		txPolicyKeeper: txPolicyKeeper,
+		walker:         NewMsgWalker(cdc),
+	}
+}
+
+// AnteHandle checks every message of the transaction, including messages wrapped
+// inside authz, group or ICA messages, against the allowed and denied lists
+func (mtpd MsgTypePolicyDecorator) AnteHandle(
+	ctx sdk.Context,
+	tx sdk.Tx,
+	simulate bool,
+	next sdk.AnteHandler,
+) (sdk.Context, error) {
+	params, err := mtpd.txPolicyKeeper.Params.Get(ctx)
+	if err != nil {
+		return ctx, errors.Wrapf(sdkerrors.ErrLogic, "failed to get txpolicy params: %v", err)
+	}
+
+	err = mtpd.walker.Walk(tx.GetMsgs(), func(msg sdk.Msg, _ int) error {
+		return params.CheckMsgTypeURL(sdk.MsgTypeURL(msg))
+	})
+	if err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
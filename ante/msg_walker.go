@@ -0,0 +1,111 @@
+package ante
+
+import (
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+)
+
+// MaxMsgNestingDepth is the maximum number of wrapper messages (authz, group,
+// ICA) that may enclose a message. Transactions nesting messages deeper than
+// this are rejected.
+const MaxMsgNestingDepth = 5
+
+// MsgWalker visits every message of a transaction, including the messages
+// wrapped inside authz.MsgExec, group.MsgSubmitProposal and the interchain
+// accounts controller MsgSendTx.
+type MsgWalker struct {
+	cdc codec.Codec
+}
+
+// NewMsgWalker creates a new MsgWalker. The codec is used to decode the
+// messages carried by ICA packets.
+func NewMsgWalker(cdc codec.Codec) MsgWalker {
+	return MsgWalker{
+		cdc: cdc,
+	}
+}
+
+// Walk calls fn for each message in msgs and, depth-first, for each message
+// wrapped inside it. Top-level messages have depth 0. Walking stops at the
+// first error returned by fn.
+func (w MsgWalker) Walk(msgs []sdk.Msg, fn func(msg sdk.Msg, depth int) error) error {
+	return w.walk(msgs, 0, fn)
+}
+
+func (w MsgWalker) walk(msgs []sdk.Msg, depth int, fn func(msg sdk.Msg, depth int) error) error {
+	if depth > MaxMsgNestingDepth {
+		return errors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"messages nested deeper than %d levels are not allowed",
+			MaxMsgNestingDepth,
+		)
+	}
+
+	for _, msg := range msgs {
+		if err := fn(msg, depth); err != nil {
+			return err
+		}
+
+		inner, err := w.innerMsgs(msg)
+		if err != nil {
+			return err
+		}
+		if len(inner) == 0 {
+			continue
+		}
+		if err := w.walk(inner, depth+1, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// innerMsgs returns the messages directly wrapped by msg, or nil if msg does
+// not wrap other messages.
+func (w MsgWalker) innerMsgs(msg sdk.Msg) ([]sdk.Msg, error) {
+	switch m := msg.(type) {
+	case *authz.MsgExec:
+		inner, err := m.GetMessages()
+		if err != nil {
+			return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "failed to unpack authz exec messages")
+		}
+		return inner, nil
+
+	case *group.MsgSubmitProposal:
+		inner, err := m.GetMsgs()
+		if err != nil {
+			return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "failed to unpack group proposal messages")
+		}
+		return inner, nil
+
+	case *icacontrollertypes.MsgSendTx:
+		return w.icaMsgs(m)
+	}
+
+	return nil, nil
+}
+
+// icaMsgs decodes the messages carried by an ICA packet. The packet encoding is
+// negotiated on the channel and is not part of the message, so both supported
+// encodings are tried.
+func (w MsgWalker) icaMsgs(msg *icacontrollertypes.MsgSendTx) ([]sdk.Msg, error) {
+	if msg.PacketData.Type != icatypes.EXECUTE_TX {
+		return nil, nil
+	}
+
+	for _, encoding := range []string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON} {
+		inner, err := icatypes.DeserializeCosmosTx(w.cdc, msg.PacketData.Data, encoding)
+		if err == nil {
+			return inner, nil
+		}
+	}
+
+	return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "failed to decode interchain account packet messages")
+}
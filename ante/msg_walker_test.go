@@ -0,0 +1,131 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/gogoproto/proto"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	"github.com/stretchr/testify/require"
+
+	"veranatest/ante"
+)
+
+// visitedMsg records a message seen by the walker together with its depth.
+type visitedMsg struct {
+	typeURL string
+	depth   int
+}
+
+func walkAll(t *testing.T, walker ante.MsgWalker, msgs ...sdk.Msg) ([]visitedMsg, error) {
+	t.Helper()
+
+	var visited []visitedMsg
+	err := walker.Walk(msgs, func(msg sdk.Msg, depth int) error {
+		visited = append(visited, visitedMsg{typeURL: sdk.MsgTypeURL(msg), depth: depth})
+		return nil
+	})
+	return visited, err
+}
+
+func newICASendTx(t *testing.T, cdc codec.Codec, encoding string, msgs ...proto.Message) *icacontrollertypes.MsgSendTx {
+	t.Helper()
+
+	data, err := icatypes.SerializeCosmosTx(cdc, msgs, encoding)
+	require.NoError(t, err)
+
+	return &icacontrollertypes.MsgSendTx{
+		PacketData: icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: data,
+		},
+	}
+}
+
+func TestMsgWalker(t *testing.T) {
+	f := setupAnteFixture(t, 2)
+	alice, bob := f.accounts[0], f.accounts[1]
+	cdc := f.app.AppCodec()
+	walker := ante.NewMsgWalker(cdc)
+
+	send := banktypes.NewMsgSend(alice.addr, bob.addr, sdk.NewCoins(sdk.NewCoin("uvna", sdkmath.NewInt(1))))
+	sendURL := sdk.MsgTypeURL(send)
+	execURL := sdk.MsgTypeURL(&authz.MsgExec{})
+
+	t.Run("top level messages", func(t *testing.T) {
+		visited, err := walkAll(t, walker, send, &group.MsgExec{ProposalId: 1})
+		require.NoError(t, err)
+		require.Equal(t, []visitedMsg{
+			{typeURL: sendURL, depth: 0},
+			{typeURL: sdk.MsgTypeURL(&group.MsgExec{}), depth: 0},
+		}, visited)
+	})
+
+	t.Run("authz and group wrappers", func(t *testing.T) {
+		proposal, err := group.NewMsgSubmitProposal(alice.addr.String(), []string{alice.addr.String()}, []sdk.Msg{send}, "", group.Exec_EXEC_UNSPECIFIED, "title", "summary")
+		require.NoError(t, err)
+		exec := authz.NewMsgExec(bob.addr, []sdk.Msg{proposal})
+
+		visited, err := walkAll(t, walker, &exec)
+		require.NoError(t, err)
+		require.Equal(t, []visitedMsg{
+			{typeURL: execURL, depth: 0},
+			{typeURL: sdk.MsgTypeURL(proposal), depth: 1},
+			{typeURL: sendURL, depth: 2},
+		}, visited)
+	})
+
+	t.Run("ica packet messages", func(t *testing.T) {
+		for _, encoding := range []string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON} {
+			visited, err := walkAll(t, walker, newICASendTx(t, cdc, encoding, send))
+			require.NoError(t, err, encoding)
+			require.Equal(t, []visitedMsg{
+				{typeURL: sdk.MsgTypeURL(&icacontrollertypes.MsgSendTx{}), depth: 0},
+				{typeURL: sendURL, depth: 1},
+			}, visited, encoding)
+		}
+	})
+
+	t.Run("undecodable ica packet is rejected", func(t *testing.T) {
+		msg := &icacontrollertypes.MsgSendTx{
+			PacketData: icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: []byte("not a cosmos tx"),
+			},
+		}
+		_, err := walkAll(t, walker, msg)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+
+	t.Run("nesting limit", func(t *testing.T) {
+		var msg sdk.Msg = send
+		for i := 0; i < ante.MaxMsgNestingDepth; i++ {
+			exec := authz.NewMsgExec(alice.addr, []sdk.Msg{msg})
+			msg = &exec
+		}
+		_, err := walkAll(t, walker, msg)
+		require.NoError(t, err)
+
+		exec := authz.NewMsgExec(alice.addr, []sdk.Msg{msg})
+		_, err = walkAll(t, walker, &exec)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+
+	t.Run("callback error stops the walk", func(t *testing.T) {
+		exec := authz.NewMsgExec(alice.addr, []sdk.Msg{send})
+		calls := 0
+		err := walker.Walk([]sdk.Msg{&exec}, func(sdk.Msg, int) error {
+			calls++
+			return sdkerrors.ErrUnauthorized
+		})
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+		require.Equal(t, 1, calls)
+	})
+}
@@ -0,0 +1,23 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tdkeeper "veranatest/x/td/keeper"
+)
+
+// PostHandlerOptions are the keepers required by the chain-specific post
+// decorators.
+type PostHandlerOptions struct {
+	TdKeeper tdkeeper.Keeper
+}
+
+// NewPostHandler returns a PostHandler that moves a share of the transaction
+// fees to the trust deposit module.
+func NewPostHandler(options PostHandlerOptions) (sdk.PostHandler, error) {
+	postDecorators := []sdk.PostDecorator{
+		NewFeeShareDecorator(options.TdKeeper),
+	}
+
+	return sdk.ChainPostDecorators(postDecorators...), nil
+}
@@ -0,0 +1,66 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	appante "veranatest/ante"
+	tdtypes "veranatest/x/td/types"
+)
+
+func TestPostHandlerFeeShare(t *testing.T) {
+	f := setupAnteFixture(t, 2)
+	alice, bob := f.accounts[0], f.accounts[1]
+
+	postHandler, err := appante.NewPostHandler(appante.PostHandlerOptions{TdKeeper: f.app.TdKeeper})
+	require.NoError(t, err)
+
+	params, err := f.app.TdKeeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.FeeShareRate = sdkmath.LegacyNewDecWithPrec(25, 2)
+	require.NoError(t, f.app.TdKeeper.Params.Set(f.ctx, params))
+
+	send := banktypes.NewMsgSend(alice.addr, bob.addr, sdk.NewCoins(sdk.NewCoin("uvna", sdkmath.NewInt(1))))
+	tx := f.signTx(t, f.newTxBuilder(t, send), []sdk.AccAddress{alice.addr}, []cryptotypes.PrivKey{alice.priv})
+
+	tdAddr := authtypes.NewModuleAddress(tdtypes.ModuleName)
+	feeCollectorAddr := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	testCases := []struct {
+		name     string
+		success  bool
+		expShare int64
+	}{
+		{name: "successful tx", success: true, expShare: 50_000},
+		{name: "failed tx", success: false, expShare: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := f.ctx.CacheContext()
+			ctx, err := f.app.AnteHandler()(ctx, tx, false)
+			require.NoError(t, err)
+
+			tdBefore := f.app.BankKeeper.GetBalance(ctx, tdAddr, "uvna").Amount
+			feesBefore := f.app.BankKeeper.GetBalance(ctx, feeCollectorAddr, "uvna").Amount
+
+			_, err = postHandler(ctx, tx, false, tc.success)
+			require.NoError(t, err)
+
+			tdAfter := f.app.BankKeeper.GetBalance(ctx, tdAddr, "uvna").Amount
+			feesAfter := f.app.BankKeeper.GetBalance(ctx, feeCollectorAddr, "uvna").Amount
+			require.Equal(t, tc.expShare, tdAfter.Sub(tdBefore).Int64())
+			require.Equal(t, tc.expShare, feesBefore.Sub(feesAfter).Int64())
+
+			funding, err := f.app.TdKeeper.GetFeeSourcedFunding(ctx)
+			require.NoError(t, err)
+			require.Equal(t, tc.expShare, funding.AmountOf("uvna").Int64())
+		})
+	}
+}
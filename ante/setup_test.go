@@ -0,0 +1,171 @@
+package ante_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"veranatest/app"
+	validatorregistrytypes "veranatest/x/validatorregistry/types"
+)
+
+const testChainID = "veranatest-ante"
+
+// testAccount is a funded genesis account together with its signing key.
+type testAccount struct {
+	priv cryptotypes.PrivKey
+	addr sdk.AccAddress
+}
+
+type anteFixture struct {
+	app      *app.App
+	ctx      sdk.Context
+	accounts []testAccount
+}
+
+// setupAnteFixture starts an app from a genesis with a single validator and
+// the given number of funded accounts, and returns a context on top of the
+// committed state.
+func setupAnteFixture(t *testing.T, numAccounts int) *anteFixture {
+	t.Helper()
+
+	appOpts := simtestutil.AppOptionsMap{flags.FlagHome: t.TempDir()}
+	a := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOpts, baseapp.SetChainID(testChainID))
+
+	valSet, err := simtestutil.CreateRandomValidatorSet()
+	require.NoError(t, err)
+
+	accounts := make([]testAccount, numAccounts)
+	genAccs := make([]authtypes.GenesisAccount, numAccounts)
+	balances := make([]banktypes.Balance, numAccounts)
+	for i := range accounts {
+		priv := secp256k1.GenPrivKey()
+		addr := sdk.AccAddress(priv.PubKey().Address())
+		accounts[i] = testAccount{priv: priv, addr: addr}
+		genAccs[i] = authtypes.NewBaseAccount(addr, nil, uint64(i), 0)
+		balances[i] = banktypes.Balance{
+			Address: addr.String(),
+			Coins: sdk.NewCoins(
+				sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100_000_000_000)),
+				sdk.NewCoin("uvna", sdkmath.NewInt(100_000_000_000)),
+			),
+		}
+	}
+
+	genesisState, err := simtestutil.GenesisStateWithValSet(a.AppCodec(), a.DefaultGenesis(), valSet, genAccs, balances...)
+	require.NoError(t, err)
+
+	// the validator is bonded at genesis, so it needs an active registry entry
+	registryGenesis := validatorregistrytypes.DefaultGenesis()
+	for _, val := range valSet.Validators {
+		operator := sdk.ValAddress(val.Address).String()
+		registryGenesis.ValidatorMap = append(registryGenesis.ValidatorMap, validatorregistrytypes.Validator{
+			Index:           operator,
+			OperatorAddress: operator,
+			Status:          validatorregistrytypes.ValidatorStatusActive,
+		})
+	}
+	genesisState[validatorregistrytypes.ModuleName] = a.AppCodec().MustMarshalJSON(registryGenesis)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	_, err = a.InitChain(&abci.RequestInitChain{
+		ChainId:         testChainID,
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+	_, err = a.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = a.Commit()
+	require.NoError(t, err)
+
+	ctx := a.NewUncachedContext(false, cmtproto.Header{ChainID: testChainID, Height: 2}).
+		WithConsensusParams(*simtestutil.DefaultConsensusParams)
+
+	return &anteFixture{app: a, ctx: ctx, accounts: accounts}
+}
+
+// newTxBuilder returns a tx builder holding msgs with a fixed fee and gas limit.
+func (f *anteFixture) newTxBuilder(t *testing.T, msgs ...sdk.Msg) client.TxBuilder {
+	t.Helper()
+
+	txBuilder := f.app.TxConfig().NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msgs...))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("uvna", sdkmath.NewInt(200_000))))
+	txBuilder.SetGasLimit(200_000)
+
+	return txBuilder
+}
+
+// signTx signs the tx held by txBuilder with the given keys, using the account
+// numbers and sequences of the given signer accounts.
+func (f *anteFixture) signTx(t *testing.T, txBuilder client.TxBuilder, signers []sdk.AccAddress, privs []cryptotypes.PrivKey) sdk.Tx {
+	t.Helper()
+	require.Len(t, privs, len(signers))
+
+	signMode := f.app.TxConfig().SignModeHandler().DefaultMode()
+	seqs := make([]uint64, len(signers))
+	accNums := make([]uint64, len(signers))
+	for i, signer := range signers {
+		acc := f.app.AuthKeeper.GetAccount(f.ctx, signer)
+		require.NotNil(t, acc)
+		accNums[i] = acc.GetAccountNumber()
+		seqs[i] = acc.GetSequence()
+	}
+
+	// First round: set empty signatures so that the signer infos are populated.
+	sigs := make([]signing.SignatureV2, len(privs))
+	for i, priv := range privs {
+		sigs[i] = signing.SignatureV2{
+			PubKey:   priv.PubKey(),
+			Data:     &signing.SingleSignatureData{SignMode: signing.SignMode(signMode)},
+			Sequence: seqs[i],
+		}
+	}
+	require.NoError(t, txBuilder.SetSignatures(sigs...))
+
+	// Second round: sign the populated tx.
+	for i, priv := range privs {
+		signerData := authsigning.SignerData{
+			Address:       signers[i].String(),
+			ChainID:       testChainID,
+			AccountNumber: accNums[i],
+			Sequence:      seqs[i],
+			PubKey:        priv.PubKey(),
+		}
+		sig, err := clienttx.SignWithPrivKey(context.Background(), signing.SignMode(signMode), signerData, txBuilder, priv, f.app.TxConfig(), seqs[i])
+		require.NoError(t, err)
+		sigs[i] = sig
+	}
+	require.NoError(t, txBuilder.SetSignatures(sigs...))
+
+	return txBuilder.GetTx()
+}
+
+// runAnte runs the app's ante handler over tx in DeliverTx mode.
+func (f *anteFixture) runAnte(tx sdk.Tx) error {
+	cacheCtx, _ := f.ctx.CacheContext()
+	_, err := f.app.AnteHandler()(cacheCtx, tx, false)
+	return err
+}
@@ -4,43 +4,76 @@ import (
 	validatorregistrykeeper "veranatest/x/validatorregistry/keeper"
 
 	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// ValidatorWhitelistDecorator checks if a validator address is whitelisted before allowing validator creation
+// ValidatorWhitelistDecorator checks if a validator address is whitelisted before allowing validator creation,
+// delegations to the validator and unjailing it, so that only validators with an active registry entry can be bonded
 type ValidatorWhitelistDecorator struct {
 	validatorRegistryKeeper validatorregistrykeeper.Keeper
+	walker                  MsgWalker
 }
 
 // NewValidatorWhitelistDecorator creates a new ValidatorWhitelistDecorator
-func NewValidatorWhitelistDecorator(validatorRegistryKeeper validatorregistrykeeper.Keeper) ValidatorWhitelistDecorator {
+func NewValidatorWhitelistDecorator(validatorRegistryKeeper validatorregistrykeeper.Keeper, cdc codec.Codec) ValidatorWhitelistDecorator {
 	return ValidatorWhitelistDecorator{
 		validatorRegistryKeeper: validatorRegistryKeeper,
+		walker:                  NewMsgWalker(cdc),
 	}
 }
 
 // AnteHandle checks if the validator creating a validator is whitelisted
 // This check runs at ALL block heights including genesis (block height 0)
-// because validatorregistry.InitGenesis runs BEFORE genutil.InitGenesis
+// because validatorregistry.InitGenesis runs BEFORE genutil.InitGenesis.
+// Delegations, redelegations and cancelled unbondings to a validator, and
+// unjailing it, can bond it too, so they need a whitelisted validator as well.
+// MsgCreateValidator wrapped inside authz, group or ICA messages is checked too.
 func (vwd ValidatorWhitelistDecorator) AnteHandle(
 	ctx sdk.Context,
 	tx sdk.Tx,
 	simulate bool,
 	next sdk.AnteHandler,
 ) (sdk.Context, error) {
-	for _, msg := range tx.GetMsgs() {
-		if createValMsg, ok := msg.(*stakingtypes.MsgCreateValidator); ok {
+	err := vwd.walker.Walk(tx.GetMsgs(), func(msg sdk.Msg, _ int) error {
+		var validatorAddress string
+		switch msg := msg.(type) {
+		case *stakingtypes.MsgCreateValidator:
 			// Check if the validator address is whitelisted in the validatorregistry module
-			if !vwd.validatorRegistryKeeper.IsValidatorWhitelisted(ctx, createValMsg.ValidatorAddress) {
-				return ctx, errors.Wrapf(
+			if !vwd.validatorRegistryKeeper.IsValidatorWhitelisted(ctx, msg.ValidatorAddress) {
+				return errors.Wrapf(
 					sdkerrors.ErrUnauthorized,
 					"validator address %s is not whitelisted. Only whitelisted validators can create validators",
-					createValMsg.ValidatorAddress,
+					msg.ValidatorAddress,
 				)
 			}
+			return nil
+		case *stakingtypes.MsgDelegate:
+			validatorAddress = msg.ValidatorAddress
+		case *stakingtypes.MsgBeginRedelegate:
+			validatorAddress = msg.ValidatorDstAddress
+		case *stakingtypes.MsgCancelUnbondingDelegation:
+			validatorAddress = msg.ValidatorAddress
+		case *slashingtypes.MsgUnjail:
+			validatorAddress = msg.ValidatorAddr
+		default:
+			return nil
 		}
+
+		if !vwd.validatorRegistryKeeper.IsValidatorWhitelisted(ctx, validatorAddress) {
+			return errors.Wrapf(
+				sdkerrors.ErrUnauthorized,
+				"validator address %s is not whitelisted. Only whitelisted validators can be bonded by %s",
+				validatorAddress, sdk.MsgTypeURL(msg),
+			)
+		}
+		return nil
+	})
+	if err != nil {
+		return ctx, err
 	}
 
 	return next(ctx, tx, simulate)
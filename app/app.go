@@ -3,9 +3,12 @@ package app
 import (
 	"fmt"
 	"io"
+	"maps"
+	"slices"
 	appante "veranatest/ante"
 	"veranatest/docs"
 	tdmodulekeeper "veranatest/x/td/keeper"
+	txpolicymodulekeeper "veranatest/x/txpolicy/keeper"
 	validatorregistrymodulekeeper "veranatest/x/validatorregistry/keeper"
 
 	clienthelpers "cosmossdk.io/client/v2/helpers"
@@ -38,6 +41,7 @@ import (
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
+	crisiskeeper "github.com/cosmos/cosmos-sdk/x/crisis/keeper"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
@@ -49,6 +53,7 @@ import (
 	protocolpoolkeeper "github.com/cosmos/cosmos-sdk/x/protocolpool/keeper"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
 	icahostkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/keeper"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v10/modules/apps/transfer/keeper"
@@ -62,6 +67,9 @@ const (
 	AccountAddressPrefix = "cosmos"
 	// ChainCoinType is the coin type of the chain.
 	ChainCoinType = 118
+	// CouncilPolicyAddress is the address of the council group policy. It is the
+	// authority of the validatorregistry, txpolicy, td and circuit modules.
+	CouncilPolicyAddress = "cosmos1afk9zr2hn2jsac63h4hm60vl9z3e5u69gndzf7c99cqge3vzwjzsfwkgpd"
 )
 
 // DefaultNodeHome default home directories for the application daemon
@@ -99,6 +107,7 @@ type App struct {
 	ParamsKeeper          paramskeeper.Keeper
 	ProtocolPoolKeeper    protocolpoolkeeper.Keeper
 	GroupKeeper           groupkeeper.Keeper
+	CrisisKeeper          *crisiskeeper.Keeper
 
 	// ibc keepers
 	IBCKeeper           *ibckeeper.Keeper
@@ -109,6 +118,7 @@ type App struct {
 
 	TdKeeper                tdmodulekeeper.Keeper
 	ValidatorregistryKeeper validatorregistrymodulekeeper.Keeper
+	TxpolicyKeeper          txpolicymodulekeeper.Keeper
 	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
 
 	// simulation manager
@@ -190,8 +200,11 @@ func New(
 		&app.ParamsKeeper,
 		&app.ProtocolPoolKeeper,
 		&app.GroupKeeper,
+		&app.CrisisKeeper,
+		&app.FeegrantKeeper,
 		&app.TdKeeper,
 		&app.ValidatorregistryKeeper,
+		&app.TxpolicyKeeper,
 	); err != nil {
 		panic(err)
 	}
@@ -203,24 +216,45 @@ func New(
 	// build app
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
 
-	// Create ante handler with validator whitelist check and group proposal timing
+	// Create ante handler with the full SDK decorator chain plus the circuit
+	// breaker, validator whitelist and group proposal timing checks.
 	// Pass validatorregistry keeper to enable KV store access for whitelist checking
 	// Pass group keeper to enable proposal timing validation
-	anteHandler, err := appante.NewAnteHandler(
-		app.AuthKeeper,
-		app.BankKeeper,
-		app.txConfig.SignModeHandler(),
-		ante.DefaultSigVerificationGasConsumer,
-		app.ValidatorregistryKeeper,
-		app.GroupKeeper,
-	)
+	anteHandler, err := appante.NewAnteHandler(appante.HandlerOptions{
+		HandlerOptions: ante.HandlerOptions{
+			AccountKeeper:   app.AuthKeeper,
+			BankKeeper:      app.BankKeeper,
+			FeegrantKeeper:  app.FeegrantKeeper,
+			SignModeHandler: app.txConfig.SignModeHandler(),
+			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+		},
+		Codec:                   app.appCodec,
+		CircuitKeeper:           &app.CircuitBreakerKeeper,
+		ValidatorRegistryKeeper: app.ValidatorregistryKeeper,
+		GroupKeeper:             app.GroupKeeper,
+		TxPolicyKeeper:          app.TxpolicyKeeper,
+	})
 	if err != nil {
-		fmt.Printf("ERROR: Failed to create ante handler: %v", err)
-		panic(err)
+		panic(fmt.Errorf("failed to create ante handler: %w", err))
 	}
 
 	app.SetAnteHandler(anteHandler)
 
+	// Move a share of the fees of each transaction to the trust deposit module
+	postHandler, err := appante.NewPostHandler(appante.PostHandlerOptions{
+		TdKeeper: app.TdKeeper,
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to create post handler: %w", err))
+	}
+
+	app.SetPostHandler(postHandler)
+
+	// The circuit breaker decorator only sees top level messages. Setting the
+	// circuit breaker on the msg service router also blocks tripped messages
+	// executed through authz, group or gov.
+	app.SetCircuitBreaker(&app.CircuitBreakerKeeper)
+
 	// register legacy modules
 	if err := app.registerIBCModules(appOpts); err != nil {
 		panic(err)
@@ -228,9 +262,20 @@ func New(
 
 	/****  Module Options ****/
 
+	// The module manager no longer registers invariants, so the invariants of
+	// every module are registered with x/crisis here, in module name order.
+	for _, name := range slices.Sorted(maps.Keys(app.ModuleManager.Modules)) {
+		if m, ok := app.ModuleManager.Modules[name].(module.HasInvariants); ok {
+			m.RegisterInvariants(app.CrisisKeeper)
+		}
+	}
+
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	overrideModules := map[string]module.AppModuleSimulation{
 		authtypes.ModuleName: auth.NewAppModule(app.appCodec, app.AuthKeeper, authsims.RandomGenesisAccounts, nil),
+		stakingtypes.ModuleName: stakingSimulation{
+			app.ModuleManager.Modules[stakingtypes.ModuleName].(module.AppModuleSimulation),
+		},
 	}
 	app.sm = module.NewSimulationManagerFromAppModules(app.ModuleManager.Modules, overrideModules)
 
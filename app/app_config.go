@@ -4,6 +4,8 @@ import (
 	"time"
 	_ "veranatest/x/td/module"
 	tdmoduletypes "veranatest/x/td/types"
+	_ "veranatest/x/txpolicy/module"
+	txpolicymoduletypes "veranatest/x/txpolicy/types"
 	_ "veranatest/x/validatorregistry/module"
 	validatorregistrymoduletypes "veranatest/x/validatorregistry/types"
 
@@ -14,6 +16,7 @@ import (
 	bankmodulev1 "cosmossdk.io/api/cosmos/bank/module/v1"
 	circuitmodulev1 "cosmossdk.io/api/cosmos/circuit/module/v1"
 	consensusmodulev1 "cosmossdk.io/api/cosmos/consensus/module/v1"
+	crisismodulev1 "cosmossdk.io/api/cosmos/crisis/module/v1"
 	distrmodulev1 "cosmossdk.io/api/cosmos/distribution/module/v1"
 	epochsmodulev1 "cosmossdk.io/api/cosmos/epochs/module/v1"
 	evidencemodulev1 "cosmossdk.io/api/cosmos/evidence/module/v1"
@@ -52,6 +55,8 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/cosmos-sdk/x/consensus" // import for side-effects
 	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	_ "github.com/cosmos/cosmos-sdk/x/crisis" // import for side-effects
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
 	_ "github.com/cosmos/cosmos-sdk/x/distribution" // import for side-effects
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	_ "github.com/cosmos/cosmos-sdk/x/epochs" // import for side-effects
@@ -90,8 +95,9 @@ var (
 		{Account: icatypes.ModuleName},
 		{Account: protocolpooltypes.ModuleName},
 		{Account: protocolpooltypes.ProtocolPoolEscrowAccount},
-		{Account: tdmoduletypes.ModuleName},
+		{Account: tdmoduletypes.ModuleName, Permissions: []string{authtypes.Burner}},
 		{Account: tdmoduletypes.VeranaPoolAccount},
+		{Account: tdmoduletypes.VeranaPoolFundingAccount},
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
 
@@ -103,8 +109,10 @@ var (
 		stakingtypes.BondedPoolName,
 		stakingtypes.NotBondedPoolName,
 		nft.ModuleName,
+		tdmoduletypes.VeranaPoolAccount,
 		// We allow the following module accounts to receive funds:
 		// govtypes.ModuleName
+		// tdmoduletypes.VeranaPoolFundingAccount
 	}
 
 	// application configuration (used by depinject)
@@ -149,6 +157,8 @@ var (
 						// chain modules
 						tdmoduletypes.ModuleName,
 						validatorregistrymoduletypes.ModuleName,
+						// crisis asserts the invariants after all other end blockers ran
+						crisistypes.ModuleName,
 						// this line is used by starport scaffolding # stargate/app/endBlockers
 					},
 					// The following is mostly only needed when ModuleName != StoreKey name.
@@ -162,6 +172,8 @@ var (
 					// properly initialized with tokens from genesis accounts.
 					// NOTE: The genutils module must also occur after auth so that it can access the params from auth.
 					// NOTE: validatorregistry must occur BEFORE genutil so whitelist is loaded before gentxs are processed
+					// NOTE: txpolicy and td must also occur before genutil, as the ante and post handlers
+					// processing gentxs read their params. td checks its epochs against x/epochs.
 					InitGenesis: []string{
 						consensustypes.ModuleName,
 						authtypes.ModuleName,
@@ -173,6 +185,9 @@ var (
 						minttypes.ModuleName,
 						// Load validatorregistry BEFORE genutil so whitelist is available
 						validatorregistrymoduletypes.ModuleName,
+						epochstypes.ModuleName,
+						tdmoduletypes.ModuleName,
+						txpolicymoduletypes.ModuleName,
 						genutiltypes.ModuleName,
 						evidencetypes.ModuleName,
 						authz.ModuleName,
@@ -182,14 +197,13 @@ var (
 						group.ModuleName,
 						upgradetypes.ModuleName,
 						circuittypes.ModuleName,
-						epochstypes.ModuleName,
 						protocolpooltypes.ModuleName, // ADD THIS
 						// ibc modules
 						ibcexported.ModuleName,
 						ibctransfertypes.ModuleName,
 						icatypes.ModuleName,
-						// chain modules
-						tdmoduletypes.ModuleName,
+						// crisis asserts the invariants once all modules are initialized
+						crisistypes.ModuleName,
 						// this line is used by starport scaffolding # stargate/app/initGenesis
 					},
 					ExportGenesis: []string{
@@ -219,6 +233,8 @@ var (
 						// chain modules
 						tdmoduletypes.ModuleName,
 						validatorregistrymoduletypes.ModuleName,
+						txpolicymoduletypes.ModuleName,
+						crisistypes.ModuleName,
 						// this line is used by starport scaffolding # stargate/app/initGenesis
 					},
 				}),
@@ -304,8 +320,11 @@ var (
 				Config: appconfig.WrapAny(&consensusmodulev1.Module{}),
 			},
 			{
-				Name:   circuittypes.ModuleName,
-				Config: appconfig.WrapAny(&circuitmodulev1.Module{}),
+				Name: circuittypes.ModuleName,
+				Config: appconfig.WrapAny(&circuitmodulev1.Module{
+					// The council can trip and reset circuits for any message type
+					Authority: CouncilPolicyAddress,
+				}),
 			},
 			{
 				Name:   paramstypes.ModuleName,
@@ -320,13 +339,25 @@ var (
 				Config: appconfig.WrapAny(&protocolpoolmodulev1.Module{}),
 			},
 			{
-				Name:   tdmoduletypes.ModuleName,
-				Config: appconfig.WrapAny(&tdmoduletypes.Module{}),
+				Name:   crisistypes.ModuleName,
+				Config: appconfig.WrapAny(&crisismodulev1.Module{}),
+			},
+			{
+				Name: tdmoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&tdmoduletypes.Module{
+					Authority: CouncilPolicyAddress,
+				}),
 			},
 			{
 				Name: validatorregistrymoduletypes.ModuleName,
 				Config: appconfig.WrapAny(&validatorregistrymoduletypes.Module{
-					Authority: "cosmos1afk9zr2hn2jsac63h4hm60vl9z3e5u69gndzf7c99cqge3vzwjzsfwkgpd",
+					Authority: CouncilPolicyAddress,
+				}),
+			},
+			{
+				Name: txpolicymoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&txpolicymoduletypes.Module{
+					Authority: CouncilPolicyAddress,
 				}),
 			},
 			// this line is used by starport scaffolding # stargate/app/moduleConfig
@@ -0,0 +1,101 @@
+package app
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	validatorregistrytypes "veranatest/x/validatorregistry/types"
+)
+
+// TestInitChainWithGentx bootstraps a chain from the gentx of a whitelisted
+// validator. Gentxs go through the ante handler, so every module it reads
+// params from must be initialized before genutil.
+func TestInitChainWithGentx(t *testing.T) {
+	const chainID = "veranatest-gentx"
+
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true,
+		simtestutil.AppOptionsMap{flags.FlagHome: t.TempDir()}, baseapp.SetChainID(chainID))
+	cdc := app.AppCodec()
+	genesis := app.DefaultGenesis()
+
+	priv := secp256k1.GenPrivKey()
+	operator := sdk.AccAddress(priv.PubKey().Address())
+	valAddr := sdk.ValAddress(operator)
+
+	var stakingGenesis stakingtypes.GenesisState
+	cdc.MustUnmarshalJSON(genesis[stakingtypes.ModuleName], &stakingGenesis)
+	selfDelegation := sdk.NewCoin(stakingGenesis.Params.BondDenom, sdk.DefaultPowerReduction.MulRaw(10))
+
+	// the operator account, funded with its self-delegation
+	authGenesis := authtypes.GetGenesisStateFromAppState(cdc, genesis)
+	accounts, err := authtypes.PackAccounts(authtypes.GenesisAccounts{authtypes.NewBaseAccount(operator, nil, 0, 0)})
+	require.NoError(t, err)
+	authGenesis.Accounts = append(authGenesis.Accounts, accounts...)
+	genesis[authtypes.ModuleName] = cdc.MustMarshalJSON(&authGenesis)
+
+	bankGenesis := banktypes.GetGenesisStateFromAppState(cdc, genesis)
+	bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{
+		Address: operator.String(),
+		Coins:   sdk.NewCoins(selfDelegation),
+	})
+	bankGenesis.Supply = bankGenesis.Supply.Add(selfDelegation)
+	genesis[banktypes.ModuleName] = cdc.MustMarshalJSON(bankGenesis)
+
+	// the operator is whitelisted
+	var registryGenesis validatorregistrytypes.GenesisState
+	cdc.MustUnmarshalJSON(genesis[validatorregistrytypes.ModuleName], &registryGenesis)
+	registryGenesis.ValidatorMap = append(registryGenesis.ValidatorMap, validatorregistrytypes.Validator{
+		Index:           valAddr.String(),
+		MemberId:        valAddr.String(),
+		OperatorAddress: valAddr.String(),
+		Status:          validatorregistrytypes.ValidatorStatusActive,
+	})
+	genesis[validatorregistrytypes.ModuleName] = cdc.MustMarshalJSON(&registryGenesis)
+
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		valAddr.String(), ed25519.GenPrivKey().PubKey(), selfDelegation,
+		stakingtypes.NewDescription("validator", "", "", "", ""),
+		stakingtypes.NewCommissionRates(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(1, 2)),
+		math.OneInt(),
+	)
+	require.NoError(t, err)
+	gentx, err := simtestutil.GenSignedMockTx(
+		rand.New(rand.NewSource(1)), app.TxConfig(), []sdk.Msg{msg}, sdk.NewCoins(),
+		simtestutil.DefaultGenTxGas, chainID, []uint64{0}, []uint64{0}, priv,
+	)
+	require.NoError(t, err)
+	genesis, err = genutil.SetGenTxsInAppGenesisState(cdc, app.TxConfig().TxJSONEncoder(), genesis, []sdk.Tx{gentx})
+	require.NoError(t, err)
+
+	appState, err := json.Marshal(genesis)
+	require.NoError(t, err)
+	res, err := app.InitChain(&abci.RequestInitChain{
+		ChainId:         chainID,
+		AppStateBytes:   appState,
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Validators, 1)
+
+	_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Hash: res.AppHash})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+}
@@ -0,0 +1,33 @@
+package app
+
+import (
+	"encoding/json"
+	"maps"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	stakingsimulation "github.com/cosmos/cosmos-sdk/x/staking/simulation"
+)
+
+// stakingSimulation simulates x/staking without MsgCreateValidator, unless its
+// weight is set in the simulation params. The ante handler rejects validators
+// not onboarded in x/validatorregistry, and random accounts are not, so
+// validators are created by the x/validatorregistry simulation instead.
+type stakingSimulation struct {
+	module.AppModuleSimulation
+}
+
+// WeightedOperations returns the x/staking operations, with a zero weight for
+// MsgCreateValidator by default.
+func (s stakingSimulation) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	appParams := maps.Clone(simState.AppParams)
+	if appParams == nil {
+		appParams = make(simtypes.AppParams)
+	}
+	if _, ok := appParams[stakingsimulation.OpWeightMsgCreateValidator]; !ok {
+		appParams[stakingsimulation.OpWeightMsgCreateValidator] = json.RawMessage("0")
+	}
+	simState.AppParams = appParams
+
+	return s.AppModuleSimulation.WeightedOperations(simState)
+}
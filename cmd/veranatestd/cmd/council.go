@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	circuittypes "cosmossdk.io/x/circuit/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+
+	appante "veranatest/ante"
+	"veranatest/app"
+)
+
+const (
+	flagGroupPolicy = "group-policy"
+	flagTitle       = "title"
+	flagSummary     = "summary"
+	flagMetadata    = "metadata"
+)
+
+// councilCommand returns the council transaction commands. Each command submits
+// a group proposal to the council policy wrapping the requested message.
+func councilCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "council",
+		Short:                      "Council group proposal subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		tripCircuitCmd(),
+		resetCircuitCmd(),
+	)
+
+	return cmd
+}
+
+func tripCircuitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trip-circuit [msg-type-url] [msg-type-url...]",
+		Short: "Submit a council proposal to disable the given message types",
+		Example: fmt.Sprintf(
+			"%s tx council trip-circuit /veranatest.td.v1.MsgFundModule --from council-member-1",
+			app.Name+"d",
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// The ante handler rejects these on-chain too, fail before broadcasting
+			for _, msgTypeURL := range args {
+				if slices.Contains(appante.ProtectedMsgTypeURLs(), msgTypeURL) {
+					return fmt.Errorf("%s is required by the council to reset circuits and cannot be disabled", msgTypeURL)
+				}
+			}
+
+			return submitCouncilProposal(cmd, func(policy string) sdk.Msg {
+				return &circuittypes.MsgTripCircuitBreaker{
+					Authority:   policy,
+					MsgTypeUrls: args,
+				}
+			}, args)
+		},
+	}
+
+	addCouncilProposalFlags(cmd)
+
+	return cmd
+}
+
+func resetCircuitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset-circuit [msg-type-url] [msg-type-url...]",
+		Short: "Submit a council proposal to re-enable the given message types",
+		Example: fmt.Sprintf(
+			"%s tx council reset-circuit /veranatest.td.v1.MsgFundModule --from council-member-1",
+			app.Name+"d",
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitCouncilProposal(cmd, func(policy string) sdk.Msg {
+				return &circuittypes.MsgResetCircuitBreaker{
+					Authority:   policy,
+					MsgTypeUrls: args,
+				}
+			}, args)
+		},
+	}
+
+	addCouncilProposalFlags(cmd)
+
+	return cmd
+}
+
+func addCouncilProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagGroupPolicy, app.CouncilPolicyAddress, "Address of the council group policy")
+	cmd.Flags().String(flagTitle, "", "Title of the proposal")
+	cmd.Flags().String(flagSummary, "", "Summary of the proposal")
+	cmd.Flags().String(flagMetadata, "", "Metadata of the proposal")
+	flags.AddTxFlagsToCmd(cmd)
+}
+
+// submitCouncilProposal builds a group proposal from the council policy
+// containing the message returned by newMsg, with the sender as proposer.
+func submitCouncilProposal(cmd *cobra.Command, newMsg func(policy string) sdk.Msg, msgTypeURLs []string) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	for _, msgTypeURL := range msgTypeURLs {
+		if !strings.HasPrefix(msgTypeURL, "/") {
+			return fmt.Errorf("invalid msg type url %q: must start with '/'", msgTypeURL)
+		}
+	}
+
+	policy, err := cmd.Flags().GetString(flagGroupPolicy)
+	if err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(policy); err != nil {
+		return fmt.Errorf("invalid group policy address: %w", err)
+	}
+
+	title, err := cmd.Flags().GetString(flagTitle)
+	if err != nil {
+		return err
+	}
+	summary, err := cmd.Flags().GetString(flagSummary)
+	if err != nil {
+		return err
+	}
+	metadata, err := cmd.Flags().GetString(flagMetadata)
+	if err != nil {
+		return err
+	}
+
+	proposal, err := group.NewMsgSubmitProposal(
+		policy,
+		[]string{clientCtx.GetFromAddress().String()},
+		[]sdk.Msg{newMsg(policy)},
+		metadata,
+		group.Exec_EXEC_UNSPECIFIED,
+		title,
+		summary,
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+}
@@ -2,6 +2,10 @@
 
 set -e
 
+# The council can also create or replace the verana_pool continuous fund at the
+# percentage the yield requires with the td MsgUpdateContinuousFund, see
+# `veranatestd query td funding-gap` and TRUST_DEPOSIT.md.
+
 # Colors for output
 RED='\033[0;31m'
 GREEN='\033[0;32m'
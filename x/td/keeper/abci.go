@@ -1,49 +1,74 @@
 package keeper
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	protocolpooltypes "github.com/cosmos/cosmos-sdk/x/protocolpool/types"
 
 	"veranatest/x/td/types"
 )
 
-// BeginBlocker handles the fund flow logic every block
+// BeginBlocker moves the continuous fund received by verana_pool_funding to
+// verana_pool, then handles the fund flow logic every block, unless yield is
+// moved at the end of an epoch, see EpochHooks. A failed fund flow does not
+// halt the chain, see runYieldFlow.
 func (k Keeper) BeginBlocker(ctx sdk.Context) error {
-	// Send calculated yield funds from verana pool to trust deposit module
-	if err := k.SendFundsFromVeranaPool(ctx); err != nil {
+	if err := k.ForwardVeranaPoolFunding(ctx); err != nil {
 		return err
 	}
 
-	// Send excess funds back to community pool
-	if err := k.SendFundsBackToCommunityPool(ctx); err != nil {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
 		return err
 	}
+	if params.YieldEpochIdentifier != "" {
+		return nil
+	}
 
-	return nil
+	return k.runYieldFlow(ctx, params, true, func(ctx sdk.Context) error {
+		// Send calculated yield funds from verana pool to trust deposit module
+		if err := k.SendFundsFromVeranaPool(ctx, params.MaxAccrualDuration); err != nil {
+			return err
+		}
+
+		// Send excess funds back to community pool, unless swept per epoch
+		if params.SweepEpochIdentifier == "" {
+			return k.SendFundsBackToCommunityPool(ctx)
+		}
+
+		return nil
+	})
+}
+
+// EndBlocker pays out the reclaimed trust deposits whose unbonding period has ended
+func (k Keeper) EndBlocker(ctx sdk.Context) error {
+	return k.CompleteMatureWithdrawals(ctx)
 }
 
-// SendFundsFromVeranaPool calculates yield amount and transfers to trust deposit module
-func (k Keeper) SendFundsFromVeranaPool(ctx sdk.Context) error {
+// SendFundsFromVeranaPool calculates yield amount and transfers to trust deposit
+// module. At most maxElapsed of block time is accrued. Yield verana pool cannot
+// pay is recorded as a shortfall, paid back first once verana pool is refilled.
+func (k Keeper) SendFundsFromVeranaPool(ctx sdk.Context, maxElapsed time.Duration) error {
 	// Get current params
 	params, err := k.Params.Get(ctx)
 	if err != nil {
 		return err
 	}
 
-	// Get blocks per year from mint module (6,311,520 based on your network config)
-	blocksPerYear := math.NewInt(6311520)
-
-	// Calculate per-block yield amount
-	// Formula: (trust_deposit_value * trust_deposit_yield_rate) / blocks_per_year
-	trustDepositValue := math.LegacyNewDecFromInt(math.NewInt(int64(params.TrustDepositValue)))
-	yieldRate := params.TrustDepositYieldRate
-
-	// Annual yield amount
-	annualYield := trustDepositValue.Mul(yieldRate)
-
-	// Per block yield amount (as LegacyDec to handle decimals)
-	perBlockYield := annualYield.Quo(math.LegacyNewDecFromInt(blocksPerYear))
+	// Calculate the yield accrued since the previous block
+	accruedYield, err := k.AccrueYield(ctx, params, maxElapsed)
+	if err != nil {
+		return err
+	}
 
 	// Get current accumulated dust
 	currentDust, err := k.GetDustAmount(ctx)
@@ -51,42 +76,44 @@ func (k Keeper) SendFundsFromVeranaPool(ctx sdk.Context) error {
 		return err
 	}
 
-	// Add current per-block yield to accumulated dust
-	totalAmount := currentDust.Add(perBlockYield)
-
-	// Convert to integer amount (1 micro unit = 1)
-	// Since we're dealing with uvna (micro units), 1 micro unit = 1
-	microUnitThreshold := math.LegacyNewDec(1)
-
-	if totalAmount.GTE(microUnitThreshold) {
-		// Get module addresses
-		veranaPool := "cosmos1jjfey42zhnwrpv8pmpxgp2jwukcy3emfsewffz"
-		veranaPoolAddr, _ := sdk.AccAddressFromBech32(veranaPool)
-
-		// Convert to integer amount for transfer
-		transferAmount := totalAmount.TruncateInt()
+	// Add accrued yield to accumulated dust, and keep what is below 1 micro
+	// unit as dust for the next block
+	totalAmount := currentDust.Add(accruedYield)
+	yieldAmount := totalAmount.TruncateInt()
+	remainingDust := totalAmount.Sub(math.LegacyNewDecFromInt(yieldAmount))
+	if err := k.SetDustAmount(ctx, remainingDust); err != nil {
+		return err
+	}
 
-		// Create coins to transfer
-		transferCoins := sdk.NewCoins(sdk.NewCoin("uvna", transferAmount))
+	// The yield owed is the shortfall still unpaid plus this block's yield
+	shortfall, err := k.GetYieldShortfall(ctx)
+	if err != nil {
+		return err
+	}
+	owed := yieldAmount.Add(math.NewIntFromUint64(shortfall.Amount))
+	if owed.IsZero() {
+		ctx.Logger().Debug("Accumulated dust amount below threshold",
+			"total_dust", remainingDust.String())
+		return nil
+	}
 
-		// Check if verana pool has sufficient balance
-		veranaPoolBalance := k.bankKeeper.GetAllBalances(ctx, veranaPoolAddr)
-		if !veranaPoolBalance.IsAllGTE(transferCoins) {
-			// Not enough funds in verana pool, skip transfer
-			return nil
-		}
+	// Transfer as much of the owed yield as verana pool holds
+	veranaPoolAddr := authtypes.NewModuleAddress(types.VeranaPoolAccount)
+	available := k.bankKeeper.GetAllBalances(ctx, veranaPoolAddr).AmountOf(params.Denom)
+	transferAmount := math.MinInt(owed, available)
+	if transferAmount.IsPositive() {
+		transferCoins := sdk.NewCoins(sdk.NewCoin(params.Denom, transferAmount))
 
 		// Transfer from verana pool to trust deposit module
 		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.VeranaPoolAccount, types.ModuleName, transferCoins); err != nil {
 			return err
 		}
 
-		// Calculate remaining dust after transfer
-		transferredAmount := math.LegacyNewDecFromInt(transferAmount)
-		remainingDust := totalAmount.Sub(transferredAmount)
-
-		// Update dust amount
-		if err := k.SetDustAmount(ctx, remainingDust); err != nil {
+		// Yield raises the value of every share
+		if err := k.AddTrustDepositValue(ctx, transferAmount); err != nil {
+			return err
+		}
+		if err := k.recordYieldTransfer(ctx, transferAmount); err != nil {
 			return err
 		}
 
@@ -94,24 +121,79 @@ func (k Keeper) SendFundsFromVeranaPool(ctx sdk.Context) error {
 		ctx.Logger().Info("Transferred yield to trust deposit module",
 			"amount", transferCoins.String(),
 			"remaining_dust", remainingDust.String())
-	} else {
-		// Amount below threshold, just accumulate dust
-		if err := k.SetDustAmount(ctx, totalAmount); err != nil {
-			return err
+	}
+
+	return k.updateYieldShortfall(ctx, params.Denom, shortfall, yieldAmount, transferAmount)
+}
+
+// AccrueYield returns the yield accrued since the previous accrual and records
+// the current block time as the last accrual time.
+//
+// Formula: trust_deposit_value * trust_deposit_yield_rate * elapsed / year
+//
+// The elapsed block time is capped at maxElapsed, so a chain halt does not pay
+// out the yield of the whole halt at once. The first
+// accrual has no previous block time and accrues one expected block interval,
+// derived from the x/mint blocks_per_year.
+func (k Keeper) AccrueYield(ctx sdk.Context, params types.Params, maxElapsed time.Duration) (math.LegacyDec, error) {
+	blockTime := ctx.BlockTime()
+
+	var elapsed time.Duration
+	accrual, err := k.YieldAccrual.Get(ctx)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		elapsed, err = k.expectedBlockInterval(ctx)
+		if err != nil {
+			return math.LegacyDec{}, err
 		}
+	case err != nil:
+		return math.LegacyDec{}, err
+	default:
+		elapsed = blockTime.Sub(accrual.LastAccrualTime)
+	}
 
-		ctx.Logger().Debug("Accumulated dust amount below threshold",
-			"total_dust", totalAmount.String())
+	if err := k.YieldAccrual.Set(ctx, types.YieldAccrual{LastAccrualTime: blockTime}); err != nil {
+		return math.LegacyDec{}, err
 	}
 
-	return nil
+	if elapsed <= 0 {
+		return math.LegacyZeroDec(), nil
+	}
+	if elapsed > maxElapsed {
+		elapsed = maxElapsed
+	}
+
+	trustDepositValue, err := k.GetTrustDepositValue(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if err := k.addValueTime(ctx, trustDepositValue, elapsed); err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return projectYield(trustDepositValue, params.TrustDepositYieldRate, elapsed), nil
+}
+
+// expectedBlockInterval returns the block interval the x/mint blocks_per_year
+// is based on
+func (k Keeper) expectedBlockInterval(ctx sdk.Context) (time.Duration, error) {
+	mintParams, err := k.mintKeeper.Params(ctx, &minttypes.QueryParamsRequest{})
+	if err != nil {
+		return 0, err
+	}
+	if mintParams.Params.BlocksPerYear == 0 {
+		return 0, fmt.Errorf("mint blocks per year must be positive")
+	}
+
+	return types.Year / time.Duration(mintParams.Params.BlocksPerYear), nil
 }
 
-// SendFundsBackToCommunityPool sends excess funds from verana pool back to community pool
+// SendFundsBackToCommunityPool sends excess funds from verana pool back to
+// community pool. The yield of retention_duration at the current trust deposit
+// value and yield rate is kept for the next transfers.
 func (k Keeper) SendFundsBackToCommunityPool(ctx sdk.Context) error {
 	// Get verana pool module address
-	veranaPool := "cosmos1jjfey42zhnwrpv8pmpxgp2jwukcy3emfsewffz"
-	veranaPoolAddr, _ := sdk.AccAddressFromBech32(veranaPool)
+	veranaPoolAddr := authtypes.NewModuleAddress(types.VeranaPoolAccount)
 
 	// Get current balance in verana pool
 	veranaPoolBalance := k.bankKeeper.GetAllBalances(ctx, veranaPoolAddr)
@@ -121,14 +203,47 @@ func (k Keeper) SendFundsBackToCommunityPool(ctx sdk.Context) error {
 		return nil
 	}
 
-	// Send all remaining funds back to protocol pool
-	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.VeranaPoolAccount, protocolpooltypes.ModuleName, veranaPoolBalance); err != nil {
+	// Keep the retention target, up to the available balance
+	retention, err := k.RetentionTarget(ctx)
+	if err != nil {
+		return err
+	}
+	retained := sdk.NewCoins()
+	for _, coin := range retention {
+		retained = retained.Add(sdk.NewCoin(coin.Denom, math.MinInt(coin.Amount, veranaPoolBalance.AmountOf(coin.Denom))))
+	}
+	excess := veranaPoolBalance.Sub(retained...)
+	if excess.IsZero() {
+		return nil
+	}
+
+	// Send the excess back to protocol pool
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.VeranaPoolAccount, protocolpooltypes.ModuleName, excess); err != nil {
 		return err
 	}
 
 	// Log the transfer
 	ctx.Logger().Info("Sent excess funds back to community pool",
-		"amount", veranaPoolBalance.String())
+		"amount", excess.String(),
+		"retained", retained.String())
 
 	return nil
 }
+
+// RetentionTarget returns the amount verana_pool keeps when its excess is sent
+// back to the community pool: the yield of retention_duration at the current
+// trust deposit value and yield rate, rounded up.
+func (k Keeper) RetentionTarget(ctx context.Context) (sdk.Coins, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	trustDepositValue, err := k.GetTrustDepositValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	retention := projectYield(trustDepositValue, params.TrustDepositYieldRate, params.RetentionDuration).Ceil().TruncateInt()
+
+	return sdk.NewCoins(sdk.NewCoin(params.Denom, retention)), nil
+}
@@ -0,0 +1,192 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	protocolpooltypes "github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+	"github.com/stretchr/testify/require"
+
+	"veranatest/x/td/types"
+)
+
+// TestBeginBlockerFirstYield checks the first accrual, which has no previous
+// block time and accrues one block interval of the mint blocks_per_year.
+func TestBeginBlockerFirstYield(t *testing.T) {
+	testCases := []struct {
+		name          string
+		blocksPerYear uint64
+		expYield      int64
+		expDust       math.LegacyDec
+	}{
+		// 100,000,000uvna at 15% is 15,000,000uvna a year
+		{name: "short block", blocksPerYear: 4_000_000, expYield: 3, expDust: math.LegacyNewDecWithPrec(75, 2)},
+		{name: "long block", blocksPerYear: 600_000, expYield: 25, expDust: math.LegacyZeroDec()},
+		// a 31557.6 second interval is capped at the default 60 seconds
+		{name: "capped block", blocksPerYear: 1000, expYield: 28, expDust: math.LegacyMustNewDecFromStr("0.519279032626055213")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := initFixture(t)
+			ctx := sdk.UnwrapSDKContext(f.ctx)
+			f.mintKeeper.params.BlocksPerYear = tc.blocksPerYear
+
+			require.NoError(t, f.keeper.TrustDepositValue.Set(ctx, 100_000_000))
+			f.bankKeeper.fundModule(types.VeranaPoolAccount, sdk.NewCoins(sdk.NewInt64Coin("uvna", 1000)))
+
+			require.NoError(t, f.keeper.BeginBlocker(ctx))
+
+			require.Equal(t, tc.expYield, f.bankKeeper.moduleBalance(types.ModuleName).AmountOf("uvna").Int64())
+			// the rest of the pool goes back to the community pool
+			require.Equal(t, 1000-tc.expYield, f.bankKeeper.moduleBalance(protocolpooltypes.ModuleName).AmountOf("uvna").Int64())
+			require.True(t, f.bankKeeper.moduleBalance(types.VeranaPoolAccount).IsZero())
+
+			dust, err := f.keeper.GetDustAmount(ctx)
+			require.NoError(t, err)
+			require.Equal(t, tc.expDust, dust)
+		})
+	}
+}
+
+func TestBeginBlockerYieldAccrual(t *testing.T) {
+	f := initFixture(t)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// 210,384,000uvna at 15% is 31,557,600uvna a year, 1uvna per second
+	require.NoError(t, f.keeper.TrustDepositValue.Set(f.ctx, 210_384_000))
+
+	blocks := []struct {
+		name     string
+		elapsed  time.Duration
+		expYield int64
+		expDust  math.LegacyDec
+	}{
+		// the default mint params expect a block every 5 seconds
+		{name: "first block", elapsed: 0, expYield: 5, expDust: math.LegacyZeroDec()},
+		{name: "slow block", elapsed: 7 * time.Second, expYield: 7, expDust: math.LegacyZeroDec()},
+		{name: "fast block", elapsed: 500 * time.Millisecond, expYield: 0, expDust: math.LegacyNewDecWithPrec(5, 1)},
+		{name: "dust paid out", elapsed: 1500 * time.Millisecond, expYield: 2, expDust: math.LegacyZeroDec()},
+		{name: "same block time", elapsed: 0, expYield: 0, expDust: math.LegacyZeroDec()},
+		{name: "sub-second block", elapsed: 250 * time.Millisecond, expYield: 0, expDust: math.LegacyNewDecWithPrec(25, 2)},
+		{name: "halt capped", elapsed: 3 * time.Hour, expYield: 60, expDust: math.LegacyNewDecWithPrec(25, 2)},
+		{name: "after halt", elapsed: 4750 * time.Millisecond, expYield: 5, expDust: math.LegacyZeroDec()},
+	}
+
+	blockTime := start
+	paid := int64(0)
+	for _, b := range blocks {
+		blockTime = blockTime.Add(b.elapsed)
+		ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(blockTime)
+		f.bankKeeper.fundModule(types.VeranaPoolAccount, sdk.NewCoins(sdk.NewInt64Coin("uvna", 1000)))
+
+		require.NoError(t, f.keeper.BeginBlocker(ctx), b.name)
+
+		paid += b.expYield
+		require.Equal(t, paid, f.bankKeeper.moduleBalance(types.ModuleName).AmountOf("uvna").Int64(), b.name)
+
+		// yield compounds into the trust deposit value, so dust drifts slightly
+		dust, err := f.keeper.GetDustAmount(ctx)
+		require.NoError(t, err)
+		require.True(t, dust.Sub(b.expDust).Abs().LT(math.LegacyNewDecWithPrec(1, 4)), "%s: dust %s", b.name, dust)
+
+		accrual, err := f.keeper.YieldAccrual.Get(ctx)
+		require.NoError(t, err)
+		require.Equal(t, blockTime, accrual.LastAccrualTime, b.name)
+	}
+}
+
+func TestBeginBlockerEmptyPool(t *testing.T) {
+	f := initFixture(t)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	require.NoError(t, f.keeper.TrustDepositValue.Set(f.ctx, 210_384_000))
+	require.NoError(t, f.keeper.YieldAccrual.Set(f.ctx, types.YieldAccrual{LastAccrualTime: start}))
+
+	// the pool cannot pay the yield, which is recorded as a shortfall
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start.Add(10 * time.Second)).WithBlockHeight(2)
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	require.True(t, f.bankKeeper.moduleBalance(types.ModuleName).IsZero())
+
+	shortfall, err := f.keeper.GetYieldShortfall(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.YieldShortfall{Amount: 10, TotalRecorded: 10, LastShortfallHeight: 2}, shortfall)
+	requireEvent(t, ctx, "yield_shortfall", map[string]string{"amount": "10uvna", "owed": "10uvna"})
+
+	// a partial refill pays back the shortfall first
+	ctx = ctx.WithBlockTime(start.Add(15 * time.Second)).WithBlockHeight(3).WithEventManager(sdk.NewEventManager())
+	f.bankKeeper.fundModule(types.VeranaPoolAccount, sdk.NewCoins(sdk.NewInt64Coin("uvna", 12)))
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	require.Equal(t, int64(12), f.bankKeeper.moduleBalance(types.ModuleName).AmountOf("uvna").Int64())
+
+	shortfall, err = f.keeper.GetYieldShortfall(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.YieldShortfall{Amount: 3, TotalRecorded: 13, TotalRepaid: 10, LastShortfallHeight: 3}, shortfall)
+	requireEvent(t, ctx, "yield_shortfall_repaid", map[string]string{"amount": "10uvna", "remaining": "0uvna"})
+	requireEvent(t, ctx, "yield_shortfall", map[string]string{"amount": "3uvna", "owed": "3uvna"})
+
+	// once the pool is funded, the rest of the shortfall is paid with the yield
+	ctx = ctx.WithBlockTime(start.Add(20 * time.Second)).WithBlockHeight(4).WithEventManager(sdk.NewEventManager())
+	f.bankKeeper.fundModule(types.VeranaPoolAccount, sdk.NewCoins(sdk.NewInt64Coin("uvna", 1000)))
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	require.Equal(t, int64(20), f.bankKeeper.moduleBalance(types.ModuleName).AmountOf("uvna").Int64())
+
+	shortfall, err = f.keeper.GetYieldShortfall(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.YieldShortfall{TotalRecorded: 13, TotalRepaid: 13, LastShortfallHeight: 3}, shortfall)
+	requireEvent(t, ctx, "yield_shortfall_repaid", map[string]string{"amount": "3uvna", "remaining": "0uvna"})
+}
+
+// requireEvent checks that an event of eventType with attrs was emitted.
+func requireEvent(t *testing.T, ctx sdk.Context, eventType string, attrs map[string]string) {
+	t.Helper()
+
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != eventType {
+			continue
+		}
+		got := make(map[string]string, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			got[attr.Key] = attr.Value
+		}
+		require.Equal(t, attrs, got)
+		return
+	}
+	t.Fatalf("no %s event", eventType)
+}
+
+func TestBeginBlockerRetention(t *testing.T) {
+	f := initFixture(t)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.RetentionDuration = 100 * time.Second
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	// 210,384,000uvna at 15% is 31,557,600uvna a year, 1uvna per second
+	require.NoError(t, f.keeper.TrustDepositValue.Set(f.ctx, 210_384_000))
+	require.NoError(t, f.keeper.TotalShares.Set(f.ctx, math.LegacyNewDec(210_384_000)))
+	require.NoError(t, f.keeper.YieldAccrual.Set(f.ctx, types.YieldAccrual{LastAccrualTime: start}))
+	f.bankKeeper.fundModule(types.VeranaPoolAccount, sdk.NewCoins(sdk.NewInt64Coin("uvna", 1000), sdk.NewInt64Coin("stake", 7)))
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start.Add(5 * time.Second))
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+
+	// 100 seconds of yield at the new trust deposit value is kept, rounded up,
+	// and other denoms are swept entirely
+	require.Equal(t, int64(5), f.bankKeeper.moduleBalance(types.ModuleName).AmountOf("uvna").Int64())
+	require.Equal(t, int64(101), f.bankKeeper.moduleBalance(types.VeranaPoolAccount).AmountOf("uvna").Int64())
+	require.True(t, f.bankKeeper.moduleBalance(types.VeranaPoolAccount).AmountOf("stake").IsZero())
+	require.Equal(t, int64(894), f.bankKeeper.moduleBalance(protocolpooltypes.ModuleName).AmountOf("uvna").Int64())
+	require.Equal(t, int64(7), f.bankKeeper.moduleBalance(protocolpooltypes.ModuleName).AmountOf("stake").Int64())
+
+	// a pool below the target keeps everything
+	ctx = ctx.WithBlockTime(start.Add(10 * time.Second))
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	require.Equal(t, int64(10), f.bankKeeper.moduleBalance(types.ModuleName).AmountOf("uvna").Int64())
+	require.Equal(t, int64(96), f.bankKeeper.moduleBalance(types.VeranaPoolAccount).AmountOf("uvna").Int64())
+	require.Equal(t, int64(894), f.bankKeeper.moduleBalance(protocolpooltypes.ModuleName).AmountOf("uvna").Int64())
+}
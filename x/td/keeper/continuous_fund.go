@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	protocolpooltypes "github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+
+	"veranatest/x/td/types"
+)
+
+// FundingGap compares the yearly yield the trust deposits require with what the
+// verana_pool continuous fund streams.
+//
+// A continuous fund streams a percentage of the community pool inflow, which is
+// estimated as the x/mint annual provisions times the x/distribution community
+// tax. The required percentage is the required yield divided by that inflow,
+// capped at the percentage the other continuous funds leave, as the percentages
+// of all continuous funds add up to at most 1.
+func (k Keeper) FundingGap(ctx context.Context) (types.QueryFundingGapResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.QueryFundingGapResponse{}, err
+	}
+	trustDepositValue, err := k.GetTrustDepositValue(ctx)
+	if err != nil {
+		return types.QueryFundingGapResponse{}, err
+	}
+	requiredYield := projectYield(trustDepositValue, params.TrustDepositYieldRate, types.Year)
+
+	inflow, err := k.communityPoolInflow(ctx, params.Denom)
+	if err != nil {
+		return types.QueryFundingGapResponse{}, err
+	}
+	communityPool, err := k.protocolPoolKeeper.GetCommunityPool(sdkCtx)
+	if err != nil {
+		return types.QueryFundingGapResponse{}, err
+	}
+
+	fund, others, err := k.veranaPoolContinuousFund(sdkCtx)
+	if err != nil {
+		return types.QueryFundingGapResponse{}, err
+	}
+	currentPercentage := math.LegacyZeroDec()
+	if fund != nil {
+		currentPercentage = fund.Percentage
+	}
+
+	available := math.LegacyMaxDec(math.LegacyOneDec().Sub(others), math.LegacyZeroDec())
+	requiredPercentage := math.LegacyZeroDec()
+	switch {
+	case !requiredYield.IsPositive():
+	case inflow.IsPositive():
+		requiredPercentage = math.LegacyMinDec(requiredYield.Quo(inflow), available)
+	default:
+		requiredPercentage = available
+	}
+
+	return types.QueryFundingGapResponse{
+		RequiredYield:       requiredYield,
+		CommunityPoolInflow: inflow,
+		CommunityPool:       communityPool,
+		RequiredPercentage:  requiredPercentage,
+		CurrentPercentage:   currentPercentage,
+		Gap:                 requiredYield.Sub(inflow.Mul(currentPercentage)),
+	}, nil
+}
+
+// UpdateContinuousFund replaces the verana_pool continuous fund with one at the
+// percentage FundingGap requires, and returns that percentage. If no yield is
+// required, the continuous fund is removed and zero is returned. The fund
+// streams to verana_pool_funding, see ForwardVeranaPoolFunding.
+func (k Keeper) UpdateContinuousFund(ctx context.Context, expiry *time.Time) (math.LegacyDec, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	gap, err := k.FundingGap(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	recipient, err := k.addressCodec.BytesToString(authtypes.NewModuleAddress(types.VeranaPoolFundingAccount))
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	authority := k.protocolPoolKeeper.GetAuthority()
+
+	fund, _, err := k.veranaPoolContinuousFund(sdkCtx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if fund != nil {
+		if _, err := k.protocolPoolMsgServer.CancelContinuousFund(ctx, &protocolpooltypes.MsgCancelContinuousFund{
+			Authority: authority,
+			Recipient: recipient,
+		}); err != nil {
+			return math.LegacyDec{}, err
+		}
+	}
+
+	if !gap.RequiredPercentage.IsPositive() {
+		return math.LegacyZeroDec(), nil
+	}
+	if _, err := k.protocolPoolMsgServer.CreateContinuousFund(ctx, &protocolpooltypes.MsgCreateContinuousFund{
+		Authority:  authority,
+		Recipient:  recipient,
+		Percentage: gap.RequiredPercentage,
+		Expiry:     expiry,
+	}); err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return gap.RequiredPercentage, nil
+}
+
+// communityPoolInflow returns the yearly community pool inflow in denom: the
+// x/mint annual provisions times the x/distribution community tax. It is zero
+// if x/mint mints another denom.
+func (k Keeper) communityPoolInflow(ctx context.Context, denom string) (math.LegacyDec, error) {
+	mintParams, err := k.mintKeeper.Params(ctx, &minttypes.QueryParamsRequest{})
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if mintParams.Params.MintDenom != denom {
+		return math.LegacyZeroDec(), nil
+	}
+	provisions, err := k.mintKeeper.AnnualProvisions(ctx, &minttypes.QueryAnnualProvisionsRequest{})
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	communityTax, err := k.distrKeeper.GetCommunityTax(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return provisions.AnnualProvisions.Mul(communityTax), nil
+}
+
+// veranaPoolContinuousFund returns the continuous fund streaming into
+// verana_pool_funding, nil if there is none, and the sum of the percentages of
+// the other continuous funds.
+func (k Keeper) veranaPoolContinuousFund(ctx sdk.Context) (*protocolpooltypes.ContinuousFund, math.LegacyDec, error) {
+	funds, err := k.protocolPoolKeeper.GetAllContinuousFunds(ctx)
+	if err != nil {
+		return nil, math.LegacyDec{}, err
+	}
+
+	fundingAddr := authtypes.NewModuleAddress(types.VeranaPoolFundingAccount)
+	var fund *protocolpooltypes.ContinuousFund
+	others := math.LegacyZeroDec()
+	for i, f := range funds {
+		recipient, err := k.addressCodec.StringToBytes(f.Recipient)
+		if err != nil {
+			return nil, math.LegacyDec{}, err
+		}
+		if fundingAddr.Equals(sdk.AccAddress(recipient)) {
+			fund = &funds[i]
+			continue
+		}
+		others = others.Add(f.Percentage)
+	}
+
+	return fund, others, nil
+}
+
+// ForwardVeranaPoolFunding moves the balance of verana_pool_funding, the
+// recipient of the continuous fund, to verana_pool. verana_pool is a blocked
+// address, so it is only funded through module accounts.
+func (k Keeper) ForwardVeranaPoolFunding(ctx context.Context) error {
+	funding := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.VeranaPoolFundingAccount))
+	if funding.IsZero() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.VeranaPoolFundingAccount, types.VeranaPoolAccount, funding)
+}
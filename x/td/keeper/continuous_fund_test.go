@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	protocolpooltypes "github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+	"github.com/stretchr/testify/require"
+
+	"veranatest/testutil/sample"
+	"veranatest/x/td/keeper"
+	"veranatest/x/td/types"
+)
+
+// setFundingInflow sets a yearly community pool inflow of 200,000uvna and a
+// trust deposit value requiring 150,000uvna of yield a year at 15%.
+func setFundingInflow(t *testing.T, f *fixture) {
+	t.Helper()
+
+	f.mintKeeper.params.MintDenom = "uvna"
+	f.mintKeeper.annualProvisions = math.LegacyNewDec(10_000_000)
+	f.distrKeeper.communityTax = math.LegacyNewDecWithPrec(2, 2)
+	f.protocolPool.communityPool = sdk.NewCoins(sdk.NewInt64Coin("uvna", 5_000_000))
+	require.NoError(t, f.keeper.TrustDepositValue.Set(f.ctx, 1_000_000))
+}
+
+func TestFundingGap(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	setFundingInflow(t, f)
+
+	gap, err := qs.FundingGap(f.ctx, &types.QueryFundingGapRequest{})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(150_000), gap.RequiredYield)
+	require.Equal(t, math.LegacyNewDec(200_000), gap.CommunityPoolInflow)
+	require.Equal(t, int64(5_000_000), gap.CommunityPool.AmountOf("uvna").Int64())
+	require.Equal(t, math.LegacyNewDecWithPrec(75, 2), gap.RequiredPercentage)
+	require.True(t, gap.CurrentPercentage.IsZero())
+	require.Equal(t, math.LegacyNewDec(150_000), gap.Gap)
+
+	// at 5%, a fund at half the inflow streams 100,000uvna, a surplus of
+	// 50,000uvna
+	f.protocolPool.funds = []protocolpooltypes.ContinuousFund{{
+		Recipient:  authtypes.NewModuleAddress(types.VeranaPoolFundingAccount).String(),
+		Percentage: math.LegacyNewDecWithPrec(5, 1),
+	}}
+	params := types.DefaultParams()
+	params.TrustDepositYieldRate = math.LegacyNewDecWithPrec(5, 2)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	gap, err = qs.FundingGap(f.ctx, &types.QueryFundingGapRequest{})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(25, 2), gap.RequiredPercentage)
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 1), gap.CurrentPercentage)
+	require.Equal(t, math.LegacyNewDec(-50_000), gap.Gap)
+
+	// other funds cap the required percentage
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.DefaultParams()))
+	f.protocolPool.funds = []protocolpooltypes.ContinuousFund{{
+		Recipient:  sample.AccAddress(),
+		Percentage: math.LegacyNewDecWithPrec(6, 1),
+	}}
+	gap, err = qs.FundingGap(f.ctx, &types.QueryFundingGapRequest{})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(4, 1), gap.RequiredPercentage)
+
+	// without inflow in the params denom, all that is left is required
+	f.mintKeeper.params.MintDenom = "stake"
+	gap, err = qs.FundingGap(f.ctx, &types.QueryFundingGapRequest{})
+	require.NoError(t, err)
+	require.True(t, gap.CommunityPoolInflow.IsZero())
+	require.Equal(t, math.LegacyNewDecWithPrec(4, 1), gap.RequiredPercentage)
+}
+
+func TestUpdateContinuousFund(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	funding := authtypes.NewModuleAddress(types.VeranaPoolFundingAccount).String()
+	setFundingInflow(t, f)
+
+	_, err = ms.UpdateContinuousFund(f.ctx, &types.MsgUpdateContinuousFund{Authority: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	// creates the fund
+	res, err := ms.UpdateContinuousFund(f.ctx, &types.MsgUpdateContinuousFund{Authority: authority})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(75, 2), res.Percentage)
+	require.Len(t, f.protocolPool.funds, 1)
+	require.Equal(t, funding, f.protocolPool.funds[0].Recipient)
+	require.Equal(t, math.LegacyNewDecWithPrec(75, 2), f.protocolPool.funds[0].Percentage)
+
+	// replaces it as the trust deposit value grows
+	require.NoError(t, f.keeper.TrustDepositValue.Set(f.ctx, 1_200_000))
+	res, err = ms.UpdateContinuousFund(f.ctx, &types.MsgUpdateContinuousFund{Authority: authority})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(9, 1), res.Percentage)
+	require.Len(t, f.protocolPool.funds, 1)
+	require.Equal(t, math.LegacyNewDecWithPrec(9, 1), f.protocolPool.funds[0].Percentage)
+
+	// removes it once no yield is required
+	require.NoError(t, f.keeper.TrustDepositValue.Set(f.ctx, 0))
+	res, err = ms.UpdateContinuousFund(f.ctx, &types.MsgUpdateContinuousFund{Authority: authority})
+	require.NoError(t, err)
+	require.True(t, res.Percentage.IsZero())
+	require.Empty(t, f.protocolPool.funds)
+}
+
+func TestForwardVeranaPoolFunding(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	params := types.DefaultParams()
+	params.YieldEpochIdentifier = "day"
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	// the continuous fund reaches verana_pool every block, even when yield is
+	// moved per epoch
+	f.bankKeeper.fundModule(types.VeranaPoolFundingAccount, sdk.NewCoins(sdk.NewInt64Coin("uvna", 700), sdk.NewInt64Coin("stake", 3)))
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	require.True(t, f.bankKeeper.moduleBalance(types.VeranaPoolFundingAccount).IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uvna", 700), sdk.NewInt64Coin("stake", 3)), f.bankKeeper.moduleBalance(types.VeranaPoolAccount))
+
+	// nothing to forward
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uvna", 700), sdk.NewInt64Coin("stake", 3)), f.bankKeeper.moduleBalance(types.VeranaPoolAccount))
+}
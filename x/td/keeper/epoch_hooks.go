@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+
+	"veranatest/x/td/types"
+)
+
+// EpochHooks moves yield from verana_pool at the end of each yield epoch, when
+// the yield_epoch_identifier param is set, and sends the excess of verana_pool
+// back to the community pool at the end of each sweep epoch, when the
+// sweep_epoch_identifier param is set.
+type EpochHooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+// EpochHooks returns the x/epochs hooks of the module.
+func (k Keeper) EpochHooks() EpochHooks {
+	return EpochHooks{k}
+}
+
+// ValidateEpochIdentifiers checks that the yield and sweep epoch identifiers of
+// params, when set, are x/epochs epochs. The hooks never fire for an unknown
+// epoch, which would stop yield without an error.
+func (k Keeper) ValidateEpochIdentifiers(ctx context.Context, params types.Params) error {
+	for _, identifier := range []string{params.YieldEpochIdentifier, params.SweepEpochIdentifier} {
+		if identifier == "" {
+			continue
+		}
+		if _, err := k.epochsKeeper.GetEpochInfo(sdk.UnwrapSDKContext(ctx), identifier); err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return errorsmod.Wrap(types.ErrUnknownEpoch, identifier)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AfterEpochEnd moves the yield accrued over a yield epoch, then returns the
+// excess of verana_pool to the community pool, unless it is swept at the end
+// of a sweep epoch. Up to one epoch duration plus max_accrual_duration of block
+// time is accrued, so a chain halt does not pay out the yield of the whole
+// halt. Failures are counted like those of the BeginBlocker, see runYieldFlow.
+func (h EpochHooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, _ int64) error {
+	params, err := h.k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if params.YieldEpochIdentifier != "" && params.YieldEpochIdentifier == epochIdentifier {
+		if err := h.k.runYieldFlow(sdkCtx, params, true, func(ctx sdk.Context) error {
+			epochInfo, err := h.k.epochsKeeper.GetEpochInfo(ctx, epochIdentifier)
+			if err != nil {
+				return err
+			}
+
+			if err := h.k.SendFundsFromVeranaPool(ctx, epochInfo.Duration+params.MaxAccrualDuration); err != nil {
+				return err
+			}
+			if params.SweepEpochIdentifier == "" {
+				return h.k.SendFundsBackToCommunityPool(ctx)
+			}
+
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	if params.SweepEpochIdentifier != "" && params.SweepEpochIdentifier == epochIdentifier {
+		return h.k.runYieldFlow(sdkCtx, params, false, h.k.SendFundsBackToCommunityPool)
+	}
+
+	return nil
+}
+
+// BeforeEpochStart implements epochstypes.EpochHooks.
+func (h EpochHooks) BeforeEpochStart(context.Context, string, int64) error {
+	return nil
+}
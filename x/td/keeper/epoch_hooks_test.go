@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	protocolpooltypes "github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+	"github.com/stretchr/testify/require"
+
+	"veranatest/x/td/keeper"
+	"veranatest/x/td/types"
+)
+
+func setYieldEpoch(t *testing.T, f *fixture, identifier string, duration time.Duration) {
+	t.Helper()
+
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.YieldEpochIdentifier = identifier
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	f.epochsKeeper.epochs[identifier] = epochstypes.EpochInfo{Identifier: identifier, Duration: duration}
+}
+
+func TestBeginBlockerSkipsInEpochMode(t *testing.T) {
+	f := initFixture(t)
+	setYieldEpoch(t, f, "hour", time.Hour)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	require.NoError(t, f.keeper.TrustDepositValue.Set(f.ctx, 210_384_000))
+	require.NoError(t, f.keeper.YieldAccrual.Set(f.ctx, types.YieldAccrual{LastAccrualTime: start}))
+	f.bankKeeper.fundModule(types.VeranaPoolAccount, sdk.NewCoins(sdk.NewInt64Coin("uvna", 10_000)))
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start.Add(10 * time.Second))
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+
+	// nothing moves between epochs
+	require.True(t, f.bankKeeper.moduleBalance(types.ModuleName).IsZero())
+	require.Equal(t, int64(10_000), f.bankKeeper.moduleBalance(types.VeranaPoolAccount).AmountOf("uvna").Int64())
+}
+
+func TestAfterEpochEnd(t *testing.T) {
+	f := initFixture(t)
+	setYieldEpoch(t, f, "hour", time.Hour)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	hooks := f.keeper.EpochHooks()
+
+	// 210,384,000uvna at 15% is 31,557,600uvna a year, 1uvna per second
+	require.NoError(t, f.keeper.TrustDepositValue.Set(f.ctx, 210_384_000))
+	require.NoError(t, f.keeper.YieldAccrual.Set(f.ctx, types.YieldAccrual{LastAccrualTime: start}))
+	f.bankKeeper.fundModule(types.VeranaPoolAccount, sdk.NewCoins(sdk.NewInt64Coin("uvna", 10_000)))
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start.Add(time.Hour))
+
+	// other epochs are ignored
+	require.NoError(t, hooks.AfterEpochEnd(ctx, "day", 1))
+	require.True(t, f.bankKeeper.moduleBalance(types.ModuleName).IsZero())
+
+	// the whole epoch is accrued, not just max_accrual_duration
+	require.NoError(t, hooks.AfterEpochEnd(ctx, "hour", 1))
+	require.Equal(t, int64(3600), f.bankKeeper.moduleBalance(types.ModuleName).AmountOf("uvna").Int64())
+	require.Equal(t, int64(6400), f.bankKeeper.moduleBalance(protocolpooltypes.ModuleName).AmountOf("uvna").Int64())
+	require.True(t, f.bankKeeper.moduleBalance(types.VeranaPoolAccount).IsZero())
+
+	// a halt is capped at one epoch plus max_accrual_duration
+	f.bankKeeper.fundModule(types.VeranaPoolAccount, sdk.NewCoins(sdk.NewInt64Coin("uvna", 10_000)))
+	ctx = ctx.WithBlockTime(start.Add(5 * time.Hour))
+	require.NoError(t, hooks.AfterEpochEnd(ctx, "hour", 2))
+	require.Equal(t, int64(3600+3660), f.bankKeeper.moduleBalance(types.ModuleName).AmountOf("uvna").Int64())
+}
+
+func TestAfterSweepEpochEnd(t *testing.T) {
+	f := initFixture(t)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	hooks := f.keeper.EpochHooks()
+
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.SweepEpochIdentifier = "day"
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	// 210,384,000uvna at 15% is 31,557,600uvna a year, 1uvna per second
+	require.NoError(t, f.keeper.TrustDepositValue.Set(f.ctx, 210_384_000))
+	require.NoError(t, f.keeper.YieldAccrual.Set(f.ctx, types.YieldAccrual{LastAccrualTime: start}))
+	f.bankKeeper.fundModule(types.VeranaPoolAccount, sdk.NewCoins(sdk.NewInt64Coin("uvna", 10_000)))
+
+	// yield still moves every block, but the pool is not swept
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start.Add(5 * time.Second))
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	require.Equal(t, int64(5), f.bankKeeper.moduleBalance(types.ModuleName).AmountOf("uvna").Int64())
+	require.Equal(t, int64(9995), f.bankKeeper.moduleBalance(types.VeranaPoolAccount).AmountOf("uvna").Int64())
+
+	// other epochs do not sweep
+	require.NoError(t, hooks.AfterEpochEnd(ctx, "hour", 1))
+	require.True(t, f.bankKeeper.moduleBalance(protocolpooltypes.ModuleName).IsZero())
+
+	require.NoError(t, hooks.AfterEpochEnd(ctx, "day", 1))
+	require.Equal(t, int64(9995), f.bankKeeper.moduleBalance(protocolpooltypes.ModuleName).AmountOf("uvna").Int64())
+	require.True(t, f.bankKeeper.moduleBalance(types.VeranaPoolAccount).IsZero())
+
+	// in epoch mode, the yield epoch moves yield without sweeping
+	setYieldEpoch(t, f, "hour", time.Hour)
+	f.bankKeeper.fundModule(types.VeranaPoolAccount, sdk.NewCoins(sdk.NewInt64Coin("uvna", 10_000)))
+	ctx = ctx.WithBlockTime(start.Add(time.Hour + 5*time.Second))
+	require.NoError(t, hooks.AfterEpochEnd(ctx, "hour", 2))
+	require.Equal(t, int64(5+3600), f.bankKeeper.moduleBalance(types.ModuleName).AmountOf("uvna").Int64())
+	require.Equal(t, int64(6400), f.bankKeeper.moduleBalance(types.VeranaPoolAccount).AmountOf("uvna").Int64())
+}
+
+func TestUnknownEpochIdentifierRejected(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	f.epochsKeeper.epochs["day"] = epochstypes.EpochInfo{Identifier: "day", Duration: 24 * time.Hour}
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	known := types.DefaultParams()
+	known.YieldEpochIdentifier = "day"
+	_, err = ms.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: authorityStr, Params: known})
+	require.NoError(t, err)
+
+	unknown := types.DefaultParams()
+	unknown.YieldEpochIdentifier = "dya"
+	_, err = ms.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: authorityStr, Params: unknown})
+	require.ErrorIs(t, err, types.ErrUnknownEpoch)
+
+	unknown = types.DefaultParams()
+	unknown.SweepEpochIdentifier = "fortnight"
+	_, err = ms.UpdateParamsPartial(f.ctx, &types.MsgUpdateParamsPartial{
+		Authority:  authorityStr,
+		Params:     unknown,
+		UpdateMask: []string{"sweep_epoch_identifier"},
+	})
+	require.ErrorIs(t, err, types.ErrUnknownEpoch)
+
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, known, params)
+
+	genState := types.DefaultGenesis()
+	genState.Params = unknown
+	imported := initFixture(t)
+	require.ErrorIs(t, imported.keeper.InitGenesis(imported.ctx, *genState), types.ErrUnknownEpoch)
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"veranatest/x/td/types"
+)
+
+// ShareTxFees moves the fee_share_rate fraction of fees from the fee collector to
+// the trust deposit module. The denom part of the share is added to the trust
+// deposit value, raising the share value like yield, and every share is
+// recorded as fee-sourced funding. It returns the amount moved.
+func (k Keeper) ShareTxFees(ctx context.Context, fees sdk.Coins) (sdk.Coins, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if params.FeeShareRate.IsNil() || !params.FeeShareRate.IsPositive() {
+		return nil, nil
+	}
+
+	share := sdk.NewCoins()
+	for _, fee := range fees {
+		amount := params.FeeShareRate.MulInt(fee.Amount).TruncateInt()
+		share = share.Add(sdk.NewCoin(fee.Denom, amount))
+	}
+	if share.IsZero() {
+		return nil, nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, share); err != nil {
+		return nil, err
+	}
+
+	if amount := share.AmountOf(params.Denom); amount.IsPositive() {
+		if err := k.AddTrustDepositValue(ctx, amount); err != nil {
+			return nil, err
+		}
+	}
+
+	total, err := k.GetFeeSourcedFunding(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.FeeSourcedFunding.Set(ctx, types.FeeSourcedFunding{Amount: total.Add(share...)}); err != nil {
+		return nil, err
+	}
+
+	return share, nil
+}
+
+// GetFeeSourcedFunding returns the total amount of fees moved to the module
+func (k Keeper) GetFeeSourcedFunding(ctx context.Context) (sdk.Coins, error) {
+	funding, err := k.FeeSourcedFunding.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdk.NewCoins(), nil
+		}
+		return nil, err
+	}
+
+	return funding.Amount, nil
+}
@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"veranatest/x/td/keeper"
+	"veranatest/x/td/types"
+)
+
+func TestShareTxFees(t *testing.T) {
+	f := initFixture(t)
+	f.bankKeeper.fundModule(authtypes.FeeCollectorName, sdk.NewCoins(
+		sdk.NewInt64Coin("uvna", 1_000_000),
+		sdk.NewInt64Coin("stake", 1_000_000),
+	))
+
+	// the default rate shares nothing
+	share, err := f.keeper.ShareTxFees(f.ctx, sdk.NewCoins(sdk.NewInt64Coin("uvna", 1000)))
+	require.NoError(t, err)
+	require.True(t, share.IsZero())
+	require.True(t, f.bankKeeper.moduleBalance(types.ModuleName).IsZero())
+
+	params := types.DefaultParams()
+	params.FeeShareRate = math.LegacyNewDecWithPrec(25, 2)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	require.NoError(t, f.keeper.Deposit(f.ctx, sdk.AccAddress("alice"), math.NewInt(1000)))
+
+	share, err = f.keeper.ShareTxFees(f.ctx, sdk.NewCoins(sdk.NewInt64Coin("uvna", 1000), sdk.NewInt64Coin("stake", 10)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uvna", 250), sdk.NewInt64Coin("stake", 2)), share)
+
+	// amounts are truncated, so a share smaller than one unit is not moved
+	share, err = f.keeper.ShareTxFees(f.ctx, sdk.NewCoins(sdk.NewInt64Coin("uvna", 3)))
+	require.NoError(t, err)
+	require.True(t, share.IsZero())
+
+	share, err = f.keeper.ShareTxFees(f.ctx, sdk.NewCoins(sdk.NewInt64Coin("uvna", 400)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uvna", 100)), share)
+
+	expected := sdk.NewCoins(sdk.NewInt64Coin("uvna", 350), sdk.NewInt64Coin("stake", 2))
+	require.Equal(t, expected, f.bankKeeper.moduleBalance(types.ModuleName))
+
+	funding, err := f.keeper.GetFeeSourcedFunding(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, expected, funding)
+
+	trustDepositValue, err := f.keeper.TrustDepositValue.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1350), trustDepositValue)
+
+	// the fee collector cannot cover a share of fees it never received
+	_, err = f.keeper.ShareTxFees(f.ctx, sdk.NewCoins(sdk.NewInt64Coin("uvna", 10_000_000)))
+	require.Error(t, err)
+}
+
+func TestFeeSourcedFundingQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	response, err := qs.FeeSourcedFunding(f.ctx, &types.QueryFeeSourcedFundingRequest{})
+	require.NoError(t, err)
+	require.True(t, response.Amount.IsZero())
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uvna", 42))
+	require.NoError(t, f.keeper.FeeSourcedFunding.Set(f.ctx, types.FeeSourcedFunding{Amount: amount}))
+
+	response, err = qs.FeeSourcedFunding(f.ctx, &types.QueryFeeSourcedFundingRequest{})
+	require.NoError(t, err)
+	require.Equal(t, amount, response.Amount)
+
+	_, err = qs.FeeSourcedFunding(f.ctx, nil)
+	require.Error(t, err)
+}
@@ -2,13 +2,107 @@ package keeper
 
 import (
 	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"veranatest/x/td/types"
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
+// x/epochs must be initialized first, as the epoch identifiers of the params are
+// checked against its epochs.
 func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
-	return k.Params.Set(ctx, genState.Params)
+	if err := k.ValidateEpochIdentifiers(ctx, genState.Params); err != nil {
+		return err
+	}
+	if err := k.ValidateFundableModules(genState.Params); err != nil {
+		return err
+	}
+	if err := k.Params.Set(ctx, genState.Params); err != nil {
+		return err
+	}
+
+	if err := k.TrustDepositValue.Set(ctx, genState.TrustDepositValue); err != nil {
+		return err
+	}
+	if !genState.DustAmount.IsNil() {
+		if err := k.SetDustAmount(ctx, genState.DustAmount); err != nil {
+			return err
+		}
+	}
+	if genState.YieldAccrual != nil {
+		if err := k.YieldAccrual.Set(ctx, *genState.YieldAccrual); err != nil {
+			return err
+		}
+	}
+	if genState.YieldDistribution != nil {
+		if err := k.YieldDistribution.Set(ctx, *genState.YieldDistribution); err != nil {
+			return err
+		}
+	}
+	if genState.YieldShortfall != nil {
+		if err := k.YieldShortfall.Set(ctx, *genState.YieldShortfall); err != nil {
+			return err
+		}
+	}
+	if genState.YieldCircuit != nil {
+		if err := k.YieldCircuit.Set(ctx, *genState.YieldCircuit); err != nil {
+			return err
+		}
+	}
+	if !genState.FeeSourcedFunding.IsZero() {
+		if err := k.FeeSourcedFunding.Set(ctx, types.FeeSourcedFunding{Amount: genState.FeeSourcedFunding}); err != nil {
+			return err
+		}
+	}
+	if !genState.TotalShares.IsNil() {
+		if err := k.TotalShares.Set(ctx, genState.TotalShares); err != nil {
+			return err
+		}
+	}
+	if !genState.TrustDepositShareValue.IsNil() {
+		if err := k.TrustDepositShareValue.Set(ctx, genState.TrustDepositShareValue); err != nil {
+			return err
+		}
+	}
+
+	for _, deposit := range genState.TrustDeposits {
+		account, err := k.addressCodec.StringToBytes(deposit.Account)
+		if err != nil {
+			return err
+		}
+		if err := k.TrustDeposits.Set(ctx, account, deposit); err != nil {
+			return err
+		}
+	}
+
+	for _, withdrawal := range genState.PendingWithdrawals {
+		account, err := k.addressCodec.StringToBytes(withdrawal.Account)
+		if err != nil {
+			return err
+		}
+		if err := k.PendingWithdrawals.Set(ctx, collections.Join(sdk.AccAddress(account), withdrawal.Id), withdrawal); err != nil {
+			return err
+		}
+	}
+	if err := k.WithdrawalSeq.Set(ctx, genState.WithdrawalSeq); err != nil {
+		return err
+	}
+
+	for _, record := range genState.SlashRecords {
+		account, err := k.addressCodec.StringToBytes(record.Account)
+		if err != nil {
+			return err
+		}
+		if err := k.SlashRecords.Set(ctx, collections.Join(sdk.AccAddress(account), record.Id), record); err != nil {
+			return err
+		}
+	}
+
+	return k.SlashSeq.Set(ctx, genState.SlashSeq)
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -20,6 +114,84 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 	if err != nil {
 		return nil, err
 	}
+	genesis.TrustDepositValue, err = k.GetTrustDepositValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	genesis.DustAmount, err = k.GetDustAmount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	genesis.FeeSourcedFunding, err = k.GetFeeSourcedFunding(ctx)
+	if err != nil {
+		return nil, err
+	}
+	genesis.TotalShares, err = k.GetTotalShares(ctx)
+	if err != nil {
+		return nil, err
+	}
+	genesis.TrustDepositShareValue, err = k.GetTrustDepositShareValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accrual, err := k.YieldAccrual.Get(ctx)
+	switch {
+	case err == nil:
+		genesis.YieldAccrual = &accrual
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, err
+	}
+	distribution, err := k.YieldDistribution.Get(ctx)
+	switch {
+	case err == nil:
+		genesis.YieldDistribution = &distribution
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, err
+	}
+	shortfall, err := k.YieldShortfall.Get(ctx)
+	switch {
+	case err == nil:
+		genesis.YieldShortfall = &shortfall
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, err
+	}
+	circuit, err := k.YieldCircuit.Get(ctx)
+	switch {
+	case err == nil:
+		genesis.YieldCircuit = &circuit
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, err
+	}
+
+	if err := k.TrustDeposits.Walk(ctx, nil, func(_ sdk.AccAddress, deposit types.TrustDeposit) (bool, error) {
+		genesis.TrustDeposits = append(genesis.TrustDeposits, deposit)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.PendingWithdrawals.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, uint64], withdrawal types.PendingWithdrawal) (bool, error) {
+		genesis.PendingWithdrawals = append(genesis.PendingWithdrawals, withdrawal)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	genesis.WithdrawalSeq, err = k.WithdrawalSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.SlashRecords.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, uint64], record types.SlashRecord) (bool, error) {
+		genesis.SlashRecords = append(genesis.SlashRecords, record)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	genesis.SlashSeq, err = k.SlashSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	return genesis, nil
 }
@@ -2,15 +2,22 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
-	"veranatest/x/td/types"
-
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+
+	"veranatest/x/td/types"
 )
 
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
-		Params: types.DefaultParams(),
+		Params:                 types.DefaultParams(),
+		TrustDepositValue:      1500,
+		DustAmount:             math.LegacyZeroDec(),
+		TotalShares:            math.LegacyZeroDec(),
+		TrustDepositShareValue: math.LegacyNewDecWithPrec(15, 1),
 	}
 
 	f := initFixture(t)
@@ -21,4 +28,57 @@ func TestGenesis(t *testing.T) {
 	require.NotNil(t, got)
 
 	require.EqualExportedValues(t, genesisState.Params, got.Params)
+	require.Equal(t, genesisState.TrustDepositValue, got.TrustDepositValue)
+	require.Equal(t, genesisState.TrustDepositShareValue, got.TrustDepositShareValue)
+	require.Nil(t, got.YieldAccrual)
+	require.Nil(t, got.YieldDistribution)
+	require.Nil(t, got.YieldShortfall)
+	require.Empty(t, got.TrustDeposits)
+}
+
+// TestGenesisRoundTrip exports the state left by deposits, yield, a reclaim and
+// a slash, imports it into a fresh store and checks it exports the same.
+func TestGenesisRoundTrip(t *testing.T) {
+	f := initFixture(t)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start).WithBlockHeight(10)
+
+	alice := setupDeposit(t, f)
+	_, err := f.keeper.ReclaimTrustDeposit(ctx, alice, 300)
+	require.NoError(t, err)
+	_, err = f.keeper.SlashTrustDeposit(ctx, alice, math.LegacyNewDecWithPrec(1, 1), true, "double signing")
+	require.NoError(t, err)
+
+	// a 0.5 second block leaves dust
+	require.NoError(t, f.keeper.YieldAccrual.Set(ctx, types.YieldAccrual{LastAccrualTime: start}))
+	f.bankKeeper.fundModule(types.VeranaPoolAccount, sdk.NewCoins(sdk.NewInt64Coin("uvna", 1000)))
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.TrustDepositYieldRate = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	require.NoError(t, f.keeper.BeginBlocker(ctx.WithBlockTime(start.Add(time.Hour))))
+
+	exported, err := f.keeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.TrustDeposits, 1)
+	require.Len(t, exported.PendingWithdrawals, 1)
+	require.Len(t, exported.SlashRecords, 1)
+	require.Equal(t, uint64(1), exported.WithdrawalSeq)
+	require.Equal(t, uint64(1), exported.SlashSeq)
+	require.NotNil(t, exported.YieldAccrual)
+	require.NotNil(t, exported.YieldDistribution)
+	require.True(t, exported.DustAmount.IsPositive())
+	require.True(t, exported.TrustDepositShareValue.GT(types.DefaultTrustDepositShareValue()))
+
+	imported := initFixture(t)
+	require.NoError(t, imported.keeper.InitGenesis(imported.ctx, *exported))
+	reexported, err := imported.keeper.ExportGenesis(imported.ctx)
+	require.NoError(t, err)
+	require.Equal(t, exported, reexported)
+
+	// the sequences continue after the imported records
+	withdrawal, err := imported.keeper.ReclaimTrustDeposit(imported.ctx, alice, 100)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), withdrawal.Id)
 }
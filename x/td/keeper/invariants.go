@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"veranatest/x/td/types"
+)
+
+const (
+	trustDepositValueRoute = "trust-deposit-value"
+	depositsRoute          = "deposits"
+	dustRoute              = "dust"
+)
+
+// invariantRoute is an invariant and the crisis route it is registered under.
+type invariantRoute struct {
+	route     string
+	invariant func(Keeper) sdk.Invariant
+}
+
+var invariantRoutes = []invariantRoute{
+	{route: trustDepositValueRoute, invariant: TrustDepositValueInvariant},
+	{route: depositsRoute, invariant: DepositsInvariant},
+	{route: dustRoute, invariant: DustInvariant},
+}
+
+// RegisterInvariants registers the td module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	for _, r := range invariantRoutes {
+		ir.RegisterRoute(types.ModuleName, r.route, r.invariant(k))
+	}
+}
+
+// RunInvariants runs the td module invariants in a cached context, so they
+// write nothing.
+func (k Keeper) RunInvariants(ctx sdk.Context) []types.InvariantResult {
+	results := make([]types.InvariantResult, 0, len(invariantRoutes))
+	for _, r := range invariantRoutes {
+		cacheCtx, _ := ctx.CacheContext()
+		msg, broken := r.invariant(k)(cacheCtx)
+		results = append(results, types.InvariantResult{Route: r.route, Broken: broken, Message: msg})
+	}
+
+	return results
+}
+
+// TrustDepositValueInvariant checks that the td module account holds at least
+// the trust deposit value and the pending withdrawals.
+func TrustDepositValueInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, trustDepositValueRoute, err.Error()), true
+		}
+		trustDepositValue, err := k.GetTrustDepositValue(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, trustDepositValueRoute, err.Error()), true
+		}
+		pending, err := k.totalPendingWithdrawals(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, trustDepositValueRoute, err.Error()), true
+		}
+
+		expected := math.NewIntFromUint64(trustDepositValue).Add(pending)
+		balance := k.moduleBalance(ctx, params.Denom)
+		broken := balance.LT(expected)
+
+		return sdk.FormatInvariant(types.ModuleName, trustDepositValueRoute, fmt.Sprintf(
+			"\ttd module balance: %s%s\n\ttrust deposit value: %d%s\n\tpending withdrawals: %s%s\n",
+			balance, params.Denom, trustDepositValue, params.Denom, pending, params.Denom,
+		)), broken
+	}
+}
+
+// DepositsInvariant checks that the td module account holds at least the
+// principal and the unpaid yield of every trust deposit, and the pending
+// withdrawals. Unlike TrustDepositValueInvariant, it adds up the trust deposit
+// records instead of trusting the total.
+func DepositsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, depositsRoute, err.Error()), true
+		}
+		shareValue, err := k.GetTrustDepositShareValue(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, depositsRoute, err.Error()), true
+		}
+
+		principal, unpaidYield := math.ZeroInt(), math.ZeroInt()
+		err = k.TrustDeposits.Walk(ctx, nil, func(_ sdk.AccAddress, deposit types.TrustDeposit) (bool, error) {
+			amount := math.NewIntFromUint64(deposit.Amount)
+			principal = principal.Add(amount)
+			if value := TrustDepositValue(deposit, shareValue); value.GT(amount) {
+				unpaidYield = unpaidYield.Add(value.Sub(amount))
+			}
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, depositsRoute, err.Error()), true
+		}
+		pending, err := k.totalPendingWithdrawals(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, depositsRoute, err.Error()), true
+		}
+
+		expected := principal.Add(unpaidYield).Add(pending)
+		balance := k.moduleBalance(ctx, params.Denom)
+		broken := balance.LT(expected)
+
+		return sdk.FormatInvariant(types.ModuleName, depositsRoute, fmt.Sprintf(
+			"\ttd module balance: %s%s\n\tdeposited: %s%s\n\tunpaid yield: %s%s\n\tpending withdrawals: %s%s\n",
+			balance, params.Denom, principal, params.Denom, unpaidYield, params.Denom, pending, params.Denom,
+		)), broken
+	}
+}
+
+// DustInvariant checks that the dust is in [0, 1), as yield of 1 or more is
+// moved by the next accrual.
+func DustInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		dust, err := k.GetDustAmount(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, dustRoute, err.Error()), true
+		}
+
+		broken := dust.IsNegative() || dust.GTE(math.LegacyOneDec())
+
+		return sdk.FormatInvariant(types.ModuleName, dustRoute, fmt.Sprintf("\tdust: %s\n", dust)), broken
+	}
+}
+
+func (k Keeper) totalPendingWithdrawals(ctx sdk.Context) (math.Int, error) {
+	pending := math.ZeroInt()
+	err := k.PendingWithdrawals.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, uint64], w types.PendingWithdrawal) (bool, error) {
+		pending = pending.Add(math.NewIntFromUint64(w.Amount))
+		return false, nil
+	})
+
+	return pending, err
+}
+
+func (k Keeper) moduleBalance(ctx sdk.Context, denom string) math.Int {
+	return k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName)).AmountOf(denom)
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"veranatest/x/td/keeper"
+	"veranatest/x/td/types"
+)
+
+func TestTrustDepositValueInvariant(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	invariant := keeper.TrustDepositValueInvariant(f.keeper)
+
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// 1000uvna deposited and 500uvna of yield, of which 300uvna is reclaimed
+	alice := setupDeposit(t, f)
+	_, err := f.keeper.ReclaimTrustDeposit(ctx, alice, 300)
+	require.NoError(t, err)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// the pending withdrawal is still owed by the module
+	require.NoError(t, f.keeper.TrustDepositValue.Set(ctx, 1300))
+	msg, broken := invariant(ctx)
+	require.True(t, broken, msg)
+
+	// coins held above what is owed do not break the invariant
+	f.bankKeeper.fundModule(types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("uvna", 100)))
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+}
+
+func TestDepositsInvariant(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	invariant := keeper.DepositsInvariant(f.keeper)
+
+	// 1000uvna deposited and 500uvna of unpaid yield
+	alice := setupDeposit(t, f)
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// a record worth more than the module holds breaks the invariant, even if
+	// the trust deposit value is consistent with the balance
+	deposit, err := f.keeper.TrustDeposits.Get(ctx, alice)
+	require.NoError(t, err)
+	deposit.Share = deposit.Share.MulInt64(2)
+	require.NoError(t, f.keeper.TrustDeposits.Set(ctx, alice, deposit))
+	msg, broken := invariant(ctx)
+	require.True(t, broken, msg)
+	_, broken = keeper.TrustDepositValueInvariant(f.keeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestDustInvariant(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	invariant := keeper.DustInvariant(f.keeper)
+
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	require.NoError(t, f.keeper.SetDustAmount(ctx, math.LegacyNewDecWithPrec(99, 2)))
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	require.NoError(t, f.keeper.SetDustAmount(ctx, math.LegacyOneDec()))
+	msg, broken := invariant(ctx)
+	require.True(t, broken, msg)
+
+	require.NoError(t, f.keeper.SetDustAmount(ctx, math.LegacyNewDecWithPrec(-1, 2)))
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+}
+
+func TestQueryInvariants(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	setupDeposit(t, f)
+
+	require.NoError(t, f.keeper.SetDustAmount(f.ctx, math.LegacyNewDec(2)))
+
+	response, err := qs.Invariants(f.ctx, &types.QueryInvariantsRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Invariants, 3)
+	broken := make(map[string]bool)
+	for _, result := range response.Invariants {
+		broken[result.Route] = result.Broken
+	}
+	require.Equal(t, map[string]bool{"trust-deposit-value": false, "deposits": false, "dust": true}, broken)
+
+	_, err = qs.Invariants(f.ctx, nil)
+	require.Error(t, err)
+}
@@ -4,13 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/math"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/core/address"
 	corestore "cosmossdk.io/core/store"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"veranatest/x/td/types"
 )
@@ -20,14 +23,48 @@ type Keeper struct {
 	cdc          codec.Codec
 	addressCodec address.Codec
 	// Address capable of executing a MsgUpdateParams message.
-	// Typically, this should be the x/gov module account.
+	// Typically, this should be the council group policy.
 	authority []byte
 
-	Schema        collections.Schema
-	Params        collections.Item[types.Params]
-	DustAmount    collections.Item[types.DustAmount]
+	Schema     collections.Schema
+	Params     collections.Item[types.Params]
+	DustAmount collections.Item[types.DustAmount]
+	// FeeSourcedFunding is the total amount of fees moved to the module by ShareTxFees
+	FeeSourcedFunding collections.Item[types.FeeSourcedFunding]
+	// YieldAccrual holds the block time yield was last accrued at
+	YieldAccrual collections.Item[types.YieldAccrual]
+	// YieldDistribution records the yield moved from verana_pool to the module
+	YieldDistribution collections.Item[types.YieldDistribution]
+	// YieldShortfall records the yield verana_pool could not pay
+	YieldShortfall collections.Item[types.YieldShortfall]
+	// YieldCircuit tracks the failures of the yield transfers and sweeps
+	YieldCircuit collections.Item[types.YieldCircuit]
+	// TrustDeposits holds the trust deposit of each account
+	TrustDeposits collections.Map[sdk.AccAddress, types.TrustDeposit]
+	// TrustDepositValue is the value of all trust deposits in uvna
+	TrustDepositValue collections.Item[uint64]
+	// TotalShares is the sum of the shares of all trust deposits
+	TotalShares collections.Item[math.LegacyDec]
+	// TrustDepositShareValue is the value of one share in uvna
+	TrustDepositShareValue collections.Item[math.LegacyDec]
+	// PendingWithdrawals holds the reclaimed trust deposits waiting for their
+	// unbonding period to end, by account and id
+	PendingWithdrawals *collections.IndexedMap[collections.Pair[sdk.AccAddress, uint64], types.PendingWithdrawal, PendingWithdrawalIndexes]
+	WithdrawalSeq      collections.Sequence
+	// SlashRecords holds the slash history of each account, by account and id
+	SlashRecords collections.Map[collections.Pair[sdk.AccAddress, uint64], types.SlashRecord]
+	SlashSeq     collections.Sequence
+
+	slashHooks *types.MultiSlashHooks
+
 	bankKeeper    types.BankKeeper
 	accountKeeper types.AccountKeeper
+	mintKeeper    types.MintKeeper
+	epochsKeeper  types.EpochsKeeper
+
+	distrKeeper           types.DistributionKeeper
+	protocolPoolKeeper    types.ProtocolPoolKeeper
+	protocolPoolMsgServer types.ProtocolPoolMsgServer
 }
 
 func NewKeeper(
@@ -37,7 +74,11 @@ func NewKeeper(
 	authority []byte,
 	bankKeeper types.BankKeeper,
 	accountKeeper types.AccountKeeper,
-
+	mintKeeper types.MintKeeper,
+	epochsKeeper types.EpochsKeeper,
+	distrKeeper types.DistributionKeeper,
+	protocolPoolKeeper types.ProtocolPoolKeeper,
+	protocolPoolMsgServer types.ProtocolPoolMsgServer,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -51,10 +92,55 @@ func NewKeeper(
 		addressCodec: addressCodec,
 		authority:    authority,
 
-		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		bankKeeper:    bankKeeper,
-		DustAmount:    collections.NewItem(sb, types.DustAmountKey, "dust_amount", codec.CollValue[types.DustAmount](cdc)),
+		Params:     collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		bankKeeper: bankKeeper,
+		DustAmount: collections.NewItem(sb, types.DustAmountKey, "dust_amount", codec.CollValue[types.DustAmount](cdc)),
+		FeeSourcedFunding: collections.NewItem(
+			sb, types.FeeSourcedFundingKey, "fee_sourced_funding", codec.CollValue[types.FeeSourcedFunding](cdc),
+		),
+		YieldAccrual: collections.NewItem(sb, types.YieldAccrualKey, "yield_accrual", codec.CollValue[types.YieldAccrual](cdc)),
+		YieldDistribution: collections.NewItem(
+			sb, types.YieldDistributionKey, "yield_distribution", codec.CollValue[types.YieldDistribution](cdc),
+		),
+		YieldShortfall: collections.NewItem(
+			sb, types.YieldShortfallKey, "yield_shortfall", codec.CollValue[types.YieldShortfall](cdc),
+		),
+		YieldCircuit: collections.NewItem(
+			sb, types.YieldCircuitKey, "yield_circuit", codec.CollValue[types.YieldCircuit](cdc),
+		),
+		TrustDeposits: collections.NewMap(
+			sb, types.TrustDepositKey, "trust_deposits", sdk.AccAddressKey, codec.CollValue[types.TrustDeposit](cdc),
+		),
+		TrustDepositValue: collections.NewItem(
+			sb, types.TrustDepositValueKey, "trust_deposit_value", collections.Uint64Value,
+		),
+		TotalShares: collections.NewItem(sb, types.TotalSharesKey, "total_shares", sdk.LegacyDecValue),
+		TrustDepositShareValue: collections.NewItem(
+			sb, types.TrustDepositShareValueKey, "trust_deposit_share_value", sdk.LegacyDecValue,
+		),
+		PendingWithdrawals: collections.NewIndexedMap(
+			sb, types.PendingWithdrawalKey, "pending_withdrawals",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key),
+			codec.CollValue[types.PendingWithdrawal](cdc),
+			newPendingWithdrawalIndexes(sb),
+		),
+		WithdrawalSeq: collections.NewSequence(sb, types.WithdrawalSeqKey, "withdrawal_seq"),
+		SlashRecords: collections.NewMap(
+			sb, types.SlashRecordKey, "slash_records",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key),
+			codec.CollValue[types.SlashRecord](cdc),
+		),
+		SlashSeq: collections.NewSequence(sb, types.SlashSeqKey, "slash_seq"),
+
+		slashHooks: &types.MultiSlashHooks{},
+
 		accountKeeper: accountKeeper,
+		mintKeeper:    mintKeeper,
+		epochsKeeper:  epochsKeeper,
+
+		distrKeeper:           distrKeeper,
+		protocolPoolKeeper:    protocolPoolKeeper,
+		protocolPoolMsgServer: protocolPoolMsgServer,
 	}
 
 	schema, err := sb.Build()
@@ -66,6 +152,38 @@ func NewKeeper(
 	return k
 }
 
+// PendingWithdrawalIndexes indexes pending withdrawals by completion time.
+type PendingWithdrawalIndexes struct {
+	CompletionTime *indexes.Multi[time.Time, collections.Pair[sdk.AccAddress, uint64], types.PendingWithdrawal]
+}
+
+// IndexesList implements collections.Indexes.
+func (i PendingWithdrawalIndexes) IndexesList() []collections.Index[collections.Pair[sdk.AccAddress, uint64], types.PendingWithdrawal] {
+	return []collections.Index[collections.Pair[sdk.AccAddress, uint64], types.PendingWithdrawal]{i.CompletionTime}
+}
+
+func newPendingWithdrawalIndexes(sb *collections.SchemaBuilder) PendingWithdrawalIndexes {
+	return PendingWithdrawalIndexes{
+		CompletionTime: indexes.NewMulti(
+			sb, types.PendingWithdrawalByCompletionTimeKey, "pending_withdrawals_by_completion_time",
+			sdk.TimeKey, collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key),
+			func(_ collections.Pair[sdk.AccAddress, uint64], w types.PendingWithdrawal) (time.Time, error) {
+				return w.CompletionTime, nil
+			},
+		),
+	}
+}
+
+// SetSlashHooks sets the hooks called after a trust deposit is slashed. It
+// panics if the hooks are already set.
+func (k Keeper) SetSlashHooks(hooks ...types.SlashHook) {
+	if len(*k.slashHooks) > 0 {
+		panic("cannot set td slash hooks twice")
+	}
+
+	*k.slashHooks = types.NewMultiSlashHooks(hooks...)
+}
+
 // GetAuthority returns the module's authority.
 func (k Keeper) GetAuthority() []byte {
 	return k.authority
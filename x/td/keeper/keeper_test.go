@@ -2,9 +2,13 @@ package keeper_test
 
 import (
 	"context"
+	"fmt"
+	"slices"
 	"testing"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -12,6 +16,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	protocolpooltypes "github.com/cosmos/cosmos-sdk/x/protocolpool/types"
 
 	"veranatest/x/td/keeper"
 	module "veranatest/x/td/module"
@@ -22,6 +29,12 @@ type fixture struct {
 	ctx          context.Context
 	keeper       keeper.Keeper
 	addressCodec address.Codec
+	bankKeeper   *mockBankKeeper
+	mintKeeper   *mockMintKeeper
+	epochsKeeper *mockEpochsKeeper
+
+	distrKeeper  *mockDistrKeeper
+	protocolPool *mockProtocolPool
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -35,12 +48,25 @@ func initFixture(t *testing.T) *fixture {
 	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	bankKeeper := newMockBankKeeper()
+	accountKeeper := &mockAccountKeeper{modules: []string{types.ModuleName, types.VeranaPoolAccount}}
+	mintKeeper := &mockMintKeeper{params: minttypes.DefaultParams(), annualProvisions: math.LegacyZeroDec()}
+	epochsKeeper := &mockEpochsKeeper{epochs: make(map[string]epochstypes.EpochInfo)}
+	distrKeeper := &mockDistrKeeper{communityTax: math.LegacyZeroDec()}
+	protocolPool := &mockProtocolPool{authority: authority.String()}
 
 	k := keeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
 		addressCodec,
 		authority,
+		bankKeeper,
+		accountKeeper,
+		mintKeeper,
+		epochsKeeper,
+		distrKeeper,
+		protocolPool,
+		protocolPool,
 	)
 
 	// Initialize params
@@ -52,5 +78,179 @@ func initFixture(t *testing.T) *fixture {
 		ctx:          ctx,
 		keeper:       k,
 		addressCodec: addressCodec,
+		bankKeeper:   bankKeeper,
+		mintKeeper:   mintKeeper,
+		epochsKeeper: epochsKeeper,
+
+		distrKeeper:  distrKeeper,
+		protocolPool: protocolPool,
+	}
+}
+
+// mockMintKeeper serves the given mint params and annual provisions.
+type mockMintKeeper struct {
+	params           minttypes.Params
+	annualProvisions math.LegacyDec
+}
+
+func (m *mockMintKeeper) Params(context.Context, *minttypes.QueryParamsRequest) (*minttypes.QueryParamsResponse, error) {
+	return &minttypes.QueryParamsResponse{Params: m.params}, nil
+}
+
+func (m *mockMintKeeper) AnnualProvisions(context.Context, *minttypes.QueryAnnualProvisionsRequest) (*minttypes.QueryAnnualProvisionsResponse, error) {
+	return &minttypes.QueryAnnualProvisionsResponse{AnnualProvisions: m.annualProvisions}, nil
+}
+
+// mockDistrKeeper serves the given community tax.
+type mockDistrKeeper struct {
+	communityTax math.LegacyDec
+}
+
+func (m *mockDistrKeeper) GetCommunityTax(context.Context) (math.LegacyDec, error) {
+	return m.communityTax, nil
+}
+
+// mockProtocolPool is an in-memory protocolpool keeper and msg server, holding
+// continuous funds by recipient.
+type mockProtocolPool struct {
+	authority     string
+	communityPool sdk.Coins
+	funds         []protocolpooltypes.ContinuousFund
+}
+
+func (m *mockProtocolPool) GetAuthority() string {
+	return m.authority
+}
+
+func (m *mockProtocolPool) GetCommunityPool(sdk.Context) (sdk.Coins, error) {
+	return m.communityPool, nil
+}
+
+func (m *mockProtocolPool) GetAllContinuousFunds(sdk.Context) ([]protocolpooltypes.ContinuousFund, error) {
+	return slices.Clone(m.funds), nil
+}
+
+func (m *mockProtocolPool) CreateContinuousFund(_ context.Context, msg *protocolpooltypes.MsgCreateContinuousFund) (*protocolpooltypes.MsgCreateContinuousFundResponse, error) {
+	if msg.Authority != m.authority {
+		return nil, fmt.Errorf("invalid authority %s", msg.Authority)
+	}
+	total := msg.Percentage
+	for _, f := range m.funds {
+		if f.Recipient == msg.Recipient {
+			return nil, fmt.Errorf("continuous fund already exists for recipient %s", msg.Recipient)
+		}
+		total = total.Add(f.Percentage)
+	}
+	if total.GT(math.LegacyOneDec()) {
+		return nil, fmt.Errorf("total funds percentage exceeds 100: %s", total)
+	}
+	m.funds = append(m.funds, protocolpooltypes.ContinuousFund{Recipient: msg.Recipient, Percentage: msg.Percentage, Expiry: msg.Expiry})
+	return &protocolpooltypes.MsgCreateContinuousFundResponse{}, nil
+}
+
+func (m *mockProtocolPool) CancelContinuousFund(_ context.Context, msg *protocolpooltypes.MsgCancelContinuousFund) (*protocolpooltypes.MsgCancelContinuousFundResponse, error) {
+	if msg.Authority != m.authority {
+		return nil, fmt.Errorf("invalid authority %s", msg.Authority)
+	}
+	for i, f := range m.funds {
+		if f.Recipient == msg.Recipient {
+			m.funds = slices.Delete(m.funds, i, i+1)
+			return &protocolpooltypes.MsgCancelContinuousFundResponse{Recipient: msg.Recipient}, nil
+		}
+	}
+	return nil, fmt.Errorf("continuous fund does not exist for recipient %s", msg.Recipient)
+}
+
+// mockEpochsKeeper serves the given epoch infos.
+type mockEpochsKeeper struct {
+	epochs map[string]epochstypes.EpochInfo
+}
+
+func (m *mockEpochsKeeper) GetEpochInfo(_ sdk.Context, identifier string) (epochstypes.EpochInfo, error) {
+	info, ok := m.epochs[identifier]
+	if !ok {
+		return epochstypes.EpochInfo{}, fmt.Errorf("%w: epoch info %s", collections.ErrNotFound, identifier)
+	}
+	return info, nil
+}
+
+// mockAccountKeeper knows the module accounts of the given modules.
+type mockAccountKeeper struct {
+	modules []string
+}
+
+func (m *mockAccountKeeper) GetAccount(context.Context, sdk.AccAddress) sdk.AccountI {
+	return nil
+}
+
+func (m *mockAccountKeeper) GetModuleAddress(moduleName string) sdk.AccAddress {
+	if !slices.Contains(m.modules, moduleName) {
+		return nil
+	}
+	return authtypes.NewModuleAddress(moduleName)
+}
+
+// mockBankKeeper is an in-memory types.BankKeeper. Module accounts are keyed by
+// their module address. Sends fail with sendErr when it is set.
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+	sendErr  error
+}
+
+func newMockBankKeeper() *mockBankKeeper {
+	return &mockBankKeeper{balances: make(map[string]sdk.Coins)}
+}
+
+// fundModule mints coins into the account of the given module.
+func (b *mockBankKeeper) fundModule(module string, amt sdk.Coins) {
+	addr := authtypes.NewModuleAddress(module).String()
+	b.balances[addr] = b.balances[addr].Add(amt...)
+}
+
+// moduleBalance returns the balance of the given module.
+func (b *mockBankKeeper) moduleBalance(module string) sdk.Coins {
+	return b.balances[authtypes.NewModuleAddress(module).String()]
+}
+
+func (b *mockBankKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	if b.sendErr != nil {
+		return b.sendErr
+	}
+	balance, hasNeg := b.balances[from.String()].SafeSub(amt...)
+	if hasNeg {
+		return fmt.Errorf("insufficient funds: %s < %s", b.balances[from.String()], amt)
+	}
+	b.balances[from.String()] = balance
+	b.balances[to.String()] = b.balances[to.String()].Add(amt...)
+	return nil
+}
+
+func (b *mockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+func (b *mockBankKeeper) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+func (b *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return b.send(senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (b *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (b *mockBankKeeper) SendCoinsFromModuleToModule(_ context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return b.send(authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (b *mockBankKeeper) BurnCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(moduleName).String()
+	balance, hasNeg := b.balances[addr].SafeSub(amt...)
+	if hasNeg {
+		return fmt.Errorf("insufficient funds: %s < %s", b.balances[addr], amt)
 	}
+	b.balances[addr] = balance
+	return nil
 }
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"veranatest/x/td/types"
+)
+
+// LockTrustDeposit locks amount of the principal of the trust deposit of
+// account, so that it cannot be reclaimed until it is unlocked. Slashes still
+// apply to the locked part.
+func (k Keeper) LockTrustDeposit(ctx context.Context, account sdk.AccAddress, amount uint64) error {
+	if amount == 0 {
+		return errorsmod.Wrap(types.ErrInvalidAmount, "amount must be positive")
+	}
+
+	deposit, _, err := k.getTrustDeposit(ctx, account)
+	if err != nil {
+		return err
+	}
+	if amount > deposit.Amount-deposit.Locked {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientTrustDeposit, "locked %d, deposited %d of which %d is locked", amount, deposit.Amount, deposit.Locked,
+		)
+	}
+
+	deposit.Locked += amount
+	return k.TrustDeposits.Set(ctx, account, deposit)
+}
+
+// UnlockTrustDeposit unlocks amount of the trust deposit of account. Only what
+// is still locked is unlocked, as slashes may have reduced the lock or removed
+// the trust deposit.
+func (k Keeper) UnlockTrustDeposit(ctx context.Context, account sdk.AccAddress, amount uint64) error {
+	deposit, err := k.TrustDeposits.Get(ctx, account)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	deposit.Locked -= min(amount, deposit.Locked)
+	return k.TrustDeposits.Set(ctx, account, deposit)
+}
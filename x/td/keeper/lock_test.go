@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"veranatest/testutil/sample"
+	"veranatest/x/td/types"
+)
+
+func TestLockTrustDeposit(t *testing.T) {
+	f := initFixture(t)
+	alice := setupDeposit(t, f)
+
+	require.ErrorIs(t, f.keeper.LockTrustDeposit(f.ctx, alice, 0), types.ErrInvalidAmount)
+	require.ErrorIs(t, f.keeper.LockTrustDeposit(f.ctx, sdk.MustAccAddressFromBech32(sample.AccAddress()), 100), types.ErrTrustDepositNotFound)
+	// only the principal can be locked, not the yield
+	require.ErrorIs(t, f.keeper.LockTrustDeposit(f.ctx, alice, 1001), types.ErrInsufficientTrustDeposit)
+
+	require.NoError(t, f.keeper.LockTrustDeposit(f.ctx, alice, 600))
+	require.ErrorIs(t, f.keeper.LockTrustDeposit(f.ctx, alice, 401), types.ErrInsufficientTrustDeposit)
+
+	// the locked principal cannot be reclaimed, the rest and the yield can
+	_, err := f.keeper.ReclaimTrustDeposit(f.ctx, alice, 401)
+	require.ErrorIs(t, err, types.ErrInsufficientTrustDeposit)
+	_, err = f.keeper.ReclaimTrustDeposit(f.ctx, alice, 400)
+	require.NoError(t, err)
+	_, err = f.keeper.ReclaimYield(f.ctx, alice)
+	require.NoError(t, err)
+
+	require.NoError(t, f.keeper.UnlockTrustDeposit(f.ctx, alice, 200))
+	_, err = f.keeper.ReclaimTrustDeposit(f.ctx, alice, 200)
+	require.NoError(t, err)
+
+	deposit, err := f.keeper.TrustDeposits.Get(f.ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, uint64(400), deposit.Amount)
+	require.Equal(t, uint64(400), deposit.Locked)
+}
+
+func TestUnlockTrustDepositAfterSlash(t *testing.T) {
+	f := initFixture(t)
+	alice := setupDeposit(t, f)
+	require.NoError(t, f.keeper.LockTrustDeposit(f.ctx, alice, 1000))
+
+	// a slash reduces the lock with the principal
+	_, err := f.keeper.SlashTrustDeposit(f.ctx, alice, math.LegacyNewDecWithPrec(1, 1), true, "double signing")
+	require.NoError(t, err)
+	deposit, err := f.keeper.TrustDeposits.Get(f.ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, uint64(900), deposit.Amount)
+	require.Equal(t, uint64(900), deposit.Locked)
+
+	// unlocking more than is locked unlocks what is left
+	require.NoError(t, f.keeper.UnlockTrustDeposit(f.ctx, alice, 1000))
+	deposit, err = f.keeper.TrustDeposits.Get(f.ctx, alice)
+	require.NoError(t, err)
+	require.Zero(t, deposit.Locked)
+
+	// a slashed away trust deposit has nothing to unlock
+	require.NoError(t, f.keeper.LockTrustDeposit(f.ctx, alice, 900))
+	_, err = f.keeper.SlashTrustDeposit(f.ctx, alice, math.LegacyOneDec(), true, "double signing")
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.UnlockTrustDeposit(f.ctx, alice, 900))
+}
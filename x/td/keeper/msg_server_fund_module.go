@@ -3,30 +3,35 @@ package keeper
 import (
 	"context"
 
-	sdkmath "cosmossdk.io/math"
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"veranatest/x/td/types"
-
-	errorsmod "cosmossdk.io/errors"
 )
 
 func (k msgServer) FundModule(ctx context.Context, msg *types.MsgFundModule) (*types.MsgFundModuleResponse, error) {
-	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
-		return nil, errorsmod.Wrap(err, "invalid authority address")
+	senderAcc, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid creator address")
 	}
-	senderAcc, _ := sdk.AccAddressFromBech32(msg.Creator)
 
-	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAcc, msg.Module, sdk.NewCoins(sdk.NewCoin("uvna", sdkmath.NewInt(msg.Amount))))
+	params, err := k.Params.Get(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if msg.Module == types.ModuleName {
+	if !params.IsFundable(msg.Module) {
+		return nil, errorsmod.Wrapf(types.ErrModuleNotFundable, "%s is not in %v", msg.Module, params.FundableModules)
+	}
+	if msg.Module == types.ModuleName && (len(msg.Amount) != 1 || msg.Amount[0].Denom != params.Denom) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "trust deposits must be in %s: %s", params.Denom, msg.Amount)
+	}
 
-		params, _ := k.Params.Get(ctx)
-		params.TrustDepositValue = params.TrustDepositValue + uint64(msg.Amount)
-		err = k.Params.Set(ctx, params)
-		if err != nil {
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAcc, msg.Module, msg.Amount); err != nil {
+		return nil, err
+	}
+	if msg.Module == types.ModuleName {
+		// funds sent to the trust deposit module are a deposit of the sender
+		if err := k.Deposit(ctx, senderAcc, msg.Amount.AmountOf(params.Denom)); err != nil {
 			return nil, err
 		}
 	}
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"veranatest/testutil/sample"
+	"veranatest/x/td/keeper"
+	"veranatest/x/td/types"
+)
+
+func TestFundModule(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	f.bankKeeper.balances[alice.String()] = sdk.NewCoins(sdk.NewInt64Coin("uvna", 10_000), sdk.NewInt64Coin("stake", 10_000))
+
+	testCases := []struct {
+		name   string
+		amount sdk.Coins
+		module string
+		expErr error
+	}{
+		{
+			name:   "trust deposit",
+			amount: sdk.NewCoins(sdk.NewInt64Coin("uvna", 100)),
+			module: types.ModuleName,
+		},
+		{
+			name:   "verana pool in any denom",
+			amount: sdk.NewCoins(sdk.NewInt64Coin("uvna", 100), sdk.NewInt64Coin("stake", 100)),
+			module: types.VeranaPoolAccount,
+		},
+		{
+			name:   "trust deposit in another denom",
+			amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			module: types.ModuleName,
+			expErr: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name:   "trust deposit in several denoms",
+			amount: sdk.NewCoins(sdk.NewInt64Coin("uvna", 100), sdk.NewInt64Coin("stake", 100)),
+			module: types.ModuleName,
+			expErr: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name:   "module not fundable",
+			amount: sdk.NewCoins(sdk.NewInt64Coin("uvna", 100)),
+			module: stakingtypes.BondedPoolName,
+			expErr: types.ErrModuleNotFundable,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			before := f.bankKeeper.moduleBalance(tc.module)
+			_, err := ms.FundModule(f.ctx, &types.MsgFundModule{Creator: alice.String(), Amount: tc.amount, Module: tc.module})
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				require.Equal(t, before, f.bankKeeper.moduleBalance(tc.module))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, before.Add(tc.amount...), f.bankKeeper.moduleBalance(tc.module))
+		})
+	}
+}
+
+func TestFundModuleDenomParam(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.Denom = "stake"
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	f.bankKeeper.balances[alice.String()] = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+
+	_, err := ms.FundModule(f.ctx, &types.MsgFundModule{
+		Creator: alice.String(),
+		Amount:  sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+		Module:  types.ModuleName,
+	})
+	require.NoError(t, err)
+
+	deposit, err := f.keeper.TrustDeposits.Get(f.ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), deposit.Amount)
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"veranatest/x/td/types"
+)
+
+func (k msgServer) ReclaimTrustDeposit(ctx context.Context, msg *types.MsgReclaimTrustDeposit) (*types.MsgReclaimTrustDepositResponse, error) {
+	creator, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid creator address")
+	}
+
+	withdrawal, err := k.Keeper.ReclaimTrustDeposit(ctx, creator, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReclaimTrustDepositResponse{
+		WithdrawalId:   withdrawal.Id,
+		CompletionTime: withdrawal.CompletionTime,
+	}, nil
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"veranatest/x/td/types"
+)
+
+func (k msgServer) ReclaimYield(ctx context.Context, msg *types.MsgReclaimYield) (*types.MsgReclaimYieldResponse, error) {
+	creator, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid creator address")
+	}
+
+	yield, err := k.Keeper.ReclaimYield(ctx, creator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReclaimYieldResponse{Amount: yield.Uint64()}, nil
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"veranatest/x/td/types"
+)
+
+func (k msgServer) ResumeYield(ctx context.Context, msg *types.MsgResumeYield) (*types.MsgResumeYieldResponse, error) {
+	authority, err := k.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !bytes.Equal(k.GetAuthority(), authority) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, msg.Authority)
+	}
+
+	if err := k.Keeper.ResumeYield(ctx); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResumeYieldResponse{}, nil
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"veranatest/x/td/types"
+)
+
+func (k msgServer) SlashTrustDeposit(ctx context.Context, msg *types.MsgSlashTrustDeposit) (*types.MsgSlashTrustDepositResponse, error) {
+	authority, err := k.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !bytes.Equal(k.GetAuthority(), authority) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, msg.Authority)
+	}
+
+	account, err := k.addressCodec.StringToBytes(msg.Account)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid account address")
+	}
+
+	amount, err := k.Keeper.SlashTrustDeposit(ctx, account, msg.Fraction, msg.Burn, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSlashTrustDepositResponse{Amount: amount.Uint64()}, nil
+}
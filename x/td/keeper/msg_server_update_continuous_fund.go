@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"veranatest/x/td/types"
+)
+
+func (k msgServer) UpdateContinuousFund(ctx context.Context, msg *types.MsgUpdateContinuousFund) (*types.MsgUpdateContinuousFundResponse, error) {
+	authority, err := k.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !bytes.Equal(k.GetAuthority(), authority) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, msg.Authority)
+	}
+
+	percentage, err := k.Keeper.UpdateContinuousFund(ctx, msg.Expiry)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateContinuousFundResponse{Percentage: percentage}, nil
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"veranatest/x/td/types"
+)
+
+func (k msgServer) UpdateParamsPartial(ctx context.Context, req *types.MsgUpdateParamsPartial) (*types.MsgUpdateParamsPartialResponse, error) {
+	authority, err := k.addressCodec.StringToBytes(req.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !bytes.Equal(k.GetAuthority(), authority) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, req.Authority)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params, err = params.ApplyUpdateMask(req.Params, req.UpdateMask)
+	if err != nil {
+		return nil, err
+	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	if err := k.validateParamsUpdate(ctx, params); err != nil {
+		return nil, err
+	}
+
+	if err := k.Params.Set(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsPartialResponse{}, nil
+}
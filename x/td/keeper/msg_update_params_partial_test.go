@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"veranatest/testutil/sample"
+	"veranatest/x/td/keeper"
+	"veranatest/x/td/types"
+)
+
+func TestMsgUpdateParamsPartial(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.UnbondingPeriod = time.Hour
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	// stale params only change the fields in the mask
+	stale := types.DefaultParams()
+	stale.TrustDepositYieldRate = math.LegacyNewDecWithPrec(16, 2)
+	_, err = ms.UpdateParamsPartial(f.ctx, &types.MsgUpdateParamsPartial{
+		Authority:  authorityStr,
+		Params:     stale,
+		UpdateMask: []string{"trust_deposit_yield_rate"},
+	})
+	require.NoError(t, err)
+
+	got, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.TrustDepositYieldRate = math.LegacyNewDecWithPrec(16, 2)
+	require.Equal(t, params, got)
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgUpdateParamsPartial
+		expErr    error
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			input: &types.MsgUpdateParamsPartial{
+				Authority:  sample.AccAddress(),
+				Params:     stale,
+				UpdateMask: []string{"trust_deposit_yield_rate"},
+			},
+			expErr: types.ErrInvalidSigner,
+		},
+		{
+			name: "empty mask",
+			input: &types.MsgUpdateParamsPartial{
+				Authority: authorityStr,
+				Params:    stale,
+			},
+			expErr: types.ErrInvalidUpdateMask,
+		},
+		{
+			name: "unknown param",
+			input: &types.MsgUpdateParamsPartial{
+				Authority:  authorityStr,
+				Params:     stale,
+				UpdateMask: []string{"yield_rate"},
+			},
+			expErr:    types.ErrInvalidUpdateMask,
+			expErrMsg: "unknown param",
+		},
+		{
+			name: "module state",
+			input: &types.MsgUpdateParamsPartial{
+				Authority:  authorityStr,
+				Params:     stale,
+				UpdateMask: []string{"trust_deposit_yield_rate", "trust_deposit_share_value"},
+			},
+			expErr:    types.ErrInvalidUpdateMask,
+			expErrMsg: "unknown param",
+		},
+		{
+			name: "invalid result",
+			input: &types.MsgUpdateParamsPartial{
+				Authority:  authorityStr,
+				Params:     types.Params{MaxYieldRate: math.LegacyNewDecWithPrec(1, 2)},
+				UpdateMask: []string{"max_yield_rate"},
+			},
+			expErrMsg: "yield rate must be between 0 and the max yield rate",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.UpdateParamsPartial(f.ctx, tc.input)
+			require.Error(t, err)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+			require.Contains(t, err.Error(), tc.expErrMsg)
+
+			unchanged, err := f.keeper.Params.Get(f.ctx)
+			require.NoError(t, err)
+			require.Equal(t, params, unchanged)
+		})
+	}
+}
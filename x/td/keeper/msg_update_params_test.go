@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	"veranatest/x/td/keeper"
@@ -36,12 +37,26 @@ func TestMsgUpdateParams(t *testing.T) {
 			expErrMsg: "invalid authority",
 		},
 		{
-			name: "send enabled param",
+			name: "empty params",
 			input: &types.MsgUpdateParams{
 				Authority: authorityStr,
 				Params:    types.Params{},
 			},
-			expErr: false,
+			expErr:    true,
+			expErrMsg: "max yield rate cannot be nil",
+		},
+		{
+			name: "yield rate above the max",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params: func() types.Params {
+					p := types.DefaultParams()
+					p.TrustDepositYieldRate = math.LegacyNewDec(100)
+					return p
+				}(),
+			},
+			expErr:    true,
+			expErrMsg: "yield rate must be between 0 and the max yield rate",
 		},
 		{
 			name: "all good",
@@ -66,3 +81,92 @@ func TestMsgUpdateParams(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUpdateParamsKeepsShareValue(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.DefaultParams()))
+	require.NoError(t, f.keeper.TotalShares.Set(f.ctx, math.LegacyNewDec(1000)))
+	require.NoError(t, f.keeper.TrustDepositValue.Set(f.ctx, 1000))
+
+	// the proposal is drafted before yield raises the share value
+	params := types.DefaultParams()
+	params.FeeShareRate = math.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, f.keeper.AddTrustDepositValue(f.ctx, math.NewInt(500)))
+
+	_, err = ms.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: authorityStr, Params: params})
+	require.NoError(t, err)
+
+	shareValue, err := f.keeper.GetTrustDepositShareValue(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(15, 1), shareValue)
+}
+
+func TestMsgUpdateParamsFundableModules(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.FundableModules = append(params.FundableModules, "verana-pool")
+	_, err = ms.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: authorityStr, Params: params})
+	require.ErrorIs(t, err, types.ErrModuleNotFundable)
+	require.ErrorContains(t, err, "verana-pool is not a module account")
+
+	_, err = ms.UpdateParamsPartial(f.ctx, &types.MsgUpdateParamsPartial{
+		Authority:  authorityStr,
+		Params:     params,
+		UpdateMask: []string{"fundable_modules"},
+	})
+	require.ErrorIs(t, err, types.ErrModuleNotFundable)
+}
+
+func TestMsgUpdateParamsDenom(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	updateDenom := func(denom string) error {
+		_, err := ms.UpdateParamsPartial(f.ctx, &types.MsgUpdateParamsPartial{
+			Authority:  authorityStr,
+			Params:     types.Params{Denom: denom},
+			UpdateMask: []string{"denom"},
+		})
+		return err
+	}
+
+	// the denom can change before anything is deposited
+	require.NoError(t, updateDenom("stake"))
+	require.NoError(t, updateDenom("uvna"))
+
+	alice := setupDeposit(t, f)
+	require.ErrorIs(t, updateDenom("stake"), types.ErrDenomLocked)
+
+	params := types.DefaultParams()
+	params.Denom = "stake"
+	_, err = ms.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: authorityStr, Params: params})
+	require.ErrorIs(t, err, types.ErrDenomLocked)
+
+	// setting the current denom is not a change
+	require.NoError(t, updateDenom("uvna"))
+
+	// a withdrawal waiting for its unbonding period is still paid in uvna
+	_, err = f.keeper.ReclaimYield(f.ctx, alice)
+	require.NoError(t, err)
+	_, err = f.keeper.ReclaimTrustDeposit(f.ctx, alice, 1000)
+	require.NoError(t, err)
+	totalShares, err := f.keeper.GetTotalShares(f.ctx)
+	require.NoError(t, err)
+	require.True(t, totalShares.IsZero())
+	err = updateDenom("stake")
+	require.ErrorIs(t, err, types.ErrDenomLocked)
+	require.ErrorContains(t, err, "withdrawals are pending in uvna")
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"veranatest/x/td/types"
+)
+
+// ValidateFundableModules checks that the fundable modules of params are module
+// accounts. Coins sent to any other name would be sent to an address no module
+// can spend from.
+func (k Keeper) ValidateFundableModules(params types.Params) error {
+	for _, module := range params.FundableModules {
+		if k.accountKeeper.GetModuleAddress(module) == nil {
+			return errorsmod.Wrapf(types.ErrModuleNotFundable, "%s is not a module account", module)
+		}
+	}
+
+	return nil
+}
+
+// validateParamsUpdate checks that params can replace the current params. The
+// denom cannot change while trust deposits or pending withdrawals are held in
+// it, as they would then be paid out in the new denom.
+func (k Keeper) validateParamsUpdate(ctx context.Context, params types.Params) error {
+	if err := k.ValidateEpochIdentifiers(ctx, params); err != nil {
+		return err
+	}
+	if err := k.ValidateFundableModules(params); err != nil {
+		return err
+	}
+
+	current, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.Denom == current.Denom {
+		return nil
+	}
+
+	totalShares, err := k.GetTotalShares(ctx)
+	if err != nil {
+		return err
+	}
+	trustDepositValue, err := k.GetTrustDepositValue(ctx)
+	if err != nil {
+		return err
+	}
+	if totalShares.IsPositive() || trustDepositValue > 0 {
+		return errorsmod.Wrapf(types.ErrDenomLocked, "trust deposits are held in %s", current.Denom)
+	}
+	withdrawals, err := k.PendingWithdrawals.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer withdrawals.Close()
+	if withdrawals.Valid() {
+		return errorsmod.Wrapf(types.ErrDenomLocked, "withdrawals are pending in %s", current.Denom)
+	}
+
+	return nil
+}
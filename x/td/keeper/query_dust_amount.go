@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"veranatest/x/td/types"
+)
+
+func (q queryServer) DustAmount(ctx context.Context, req *types.QueryDustAmountRequest) (*types.QueryDustAmountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	dust, err := q.k.GetDustAmount(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryDustAmountResponse{Dust: dust}, nil
+}
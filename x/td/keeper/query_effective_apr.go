@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"veranatest/x/td/types"
+)
+
+func (q queryServer) EffectiveAPR(ctx context.Context, req *types.QueryEffectiveAPRRequest) (*types.QueryEffectiveAPRResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	apr, err := q.k.EffectiveAPR(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryEffectiveAPRResponse{Apr: apr}, nil
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"veranatest/x/td/types"
+)
+
+func (q queryServer) FeeSourcedFunding(ctx context.Context, req *types.QueryFeeSourcedFundingRequest) (*types.QueryFeeSourcedFundingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	amount, err := q.k.GetFeeSourcedFunding(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryFeeSourcedFundingResponse{Amount: amount}, nil
+}
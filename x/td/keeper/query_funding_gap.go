@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"veranatest/x/td/types"
+)
+
+func (q queryServer) FundingGap(ctx context.Context, req *types.QueryFundingGapRequest) (*types.QueryFundingGapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	gap, err := q.k.FundingGap(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &gap, nil
+}
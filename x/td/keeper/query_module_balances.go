@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"veranatest/x/td/types"
+)
+
+func (q queryServer) ModuleBalances(ctx context.Context, req *types.QueryModuleBalancesRequest) (*types.QueryModuleBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return &types.QueryModuleBalancesResponse{
+		Td:         q.k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName)),
+		VeranaPool: q.k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.VeranaPoolAccount)),
+	}, nil
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"veranatest/x/td/types"
+)
+
+func (q queryServer) PendingWithdrawals(ctx context.Context, req *types.QueryPendingWithdrawalsRequest) (*types.QueryPendingWithdrawalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	account, err := q.k.addressCodec.StringToBytes(req.Account)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid account address")
+	}
+
+	withdrawals, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.PendingWithdrawals,
+		req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, uint64], w types.PendingWithdrawal) (types.PendingWithdrawal, error) {
+			return w, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](account),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingWithdrawalsResponse{PendingWithdrawals: withdrawals, Pagination: pageRes}, nil
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"veranatest/x/td/types"
+)
+
+func (q queryServer) ProjectedYield(ctx context.Context, req *types.QueryProjectedYieldRequest) (*types.QueryProjectedYieldResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Duration < 0 {
+		return nil, status.Error(codes.InvalidArgument, "duration cannot be negative")
+	}
+
+	yield, err := q.k.ProjectedYield(ctx, req.Duration)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryProjectedYieldResponse{Yield: yield}, nil
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"veranatest/x/td/types"
+)
+
+func (q queryServer) SlashHistory(ctx context.Context, req *types.QuerySlashHistoryRequest) (*types.QuerySlashHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	account, err := q.k.addressCodec.StringToBytes(req.Account)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid account address")
+	}
+
+	records, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.SlashRecords,
+		req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, uint64], r types.SlashRecord) (types.SlashRecord, error) {
+			return r, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](account),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySlashHistoryResponse{SlashRecords: records, Pagination: pageRes}, nil
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"veranatest/x/td/types"
+)
+
+func (q queryServer) TrustDeposit(ctx context.Context, req *types.QueryTrustDepositRequest) (*types.QueryTrustDepositResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	account, err := q.k.addressCodec.StringToBytes(req.Account)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid account address")
+	}
+
+	deposit, err := q.k.TrustDeposits.Get(ctx, account)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "trust deposit not found")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	shareValue, err := q.k.GetTrustDepositShareValue(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryTrustDepositResponse{
+		TrustDeposit: deposit,
+		Value:        TrustDepositValue(deposit, shareValue).Uint64(),
+		ShareValue:   shareValue,
+	}, nil
+}
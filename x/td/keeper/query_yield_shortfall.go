@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"veranatest/x/td/types"
+)
+
+func (q queryServer) YieldShortfall(ctx context.Context, req *types.QueryYieldShortfallRequest) (*types.QueryYieldShortfallResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	shortfall, err := q.k.GetYieldShortfall(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryYieldShortfallResponse{YieldShortfall: shortfall}, nil
+}
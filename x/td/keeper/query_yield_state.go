@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"veranatest/x/td/types"
+)
+
+func (q queryServer) YieldState(ctx context.Context, req *types.QueryYieldStateRequest) (*types.QueryYieldStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	perSecond, err := q.k.ProjectedYield(ctx, time.Second)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	blockInterval, err := q.k.expectedBlockInterval(sdk.UnwrapSDKContext(ctx))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	perBlock, err := q.k.ProjectedYield(ctx, blockInterval)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	distribution, err := q.k.GetYieldDistribution(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	circuit, err := q.k.GetYieldCircuit(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryYieldStateResponse{
+		YieldPerSecond:     perSecond,
+		YieldPerBlock:      perBlock,
+		LastTransferAmount: distribution.LastTransferAmount,
+		LastTransferHeight: distribution.LastTransferHeight,
+		LastTransferTime:   distribution.LastTransferTime,
+		TotalTransferred:   distribution.TotalTransferred,
+		YieldCircuit:       circuit,
+	}, nil
+}
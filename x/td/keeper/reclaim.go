@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"veranatest/x/td/types"
+)
+
+// ReclaimYield pays out the yield earned by the trust deposit of account, the
+// part of its value above the amount deposited. The shares worth the yield,
+// rounded up, are removed from the deposit.
+func (k Keeper) ReclaimYield(ctx context.Context, account sdk.AccAddress) (math.Int, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+	deposit, shareValue, err := k.getTrustDeposit(ctx, account)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	yield := TrustDepositValue(deposit, shareValue).Sub(math.NewIntFromUint64(deposit.Amount))
+	if !yield.IsPositive() {
+		return math.Int{}, types.ErrNoYield
+	}
+
+	if err := k.removeShares(ctx, shareValue, account, deposit, yield, 0); err != nil {
+		return math.Int{}, err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(params.Denom, yield))
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, account, coins); err != nil {
+		return math.Int{}, err
+	}
+
+	return yield, nil
+}
+
+// ReclaimTrustDeposit withdraws amount of principal from the trust deposit of
+// account. The shares worth amount, rounded up, are removed from the deposit
+// at once and the amount is paid out by the EndBlocker once the unbonding
+// period has passed.
+func (k Keeper) ReclaimTrustDeposit(ctx context.Context, account sdk.AccAddress, amount uint64) (types.PendingWithdrawal, error) {
+	if amount == 0 {
+		return types.PendingWithdrawal{}, errorsmod.Wrap(types.ErrInvalidAmount, "amount must be positive")
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.PendingWithdrawal{}, err
+	}
+	deposit, shareValue, err := k.getTrustDeposit(ctx, account)
+	if err != nil {
+		return types.PendingWithdrawal{}, err
+	}
+	if amount > deposit.Amount {
+		return types.PendingWithdrawal{}, errorsmod.Wrapf(
+			types.ErrInsufficientTrustDeposit, "reclaimed %d, deposited %d", amount, deposit.Amount,
+		)
+	}
+	if amount > deposit.Amount-deposit.Locked {
+		return types.PendingWithdrawal{}, errorsmod.Wrapf(
+			types.ErrInsufficientTrustDeposit, "reclaimed %d, deposited %d of which %d is locked", amount, deposit.Amount, deposit.Locked,
+		)
+	}
+
+	if err := k.removeShares(ctx, shareValue, account, deposit, math.NewIntFromUint64(amount), amount); err != nil {
+		return types.PendingWithdrawal{}, err
+	}
+
+	id, err := k.WithdrawalSeq.Next(ctx)
+	if err != nil {
+		return types.PendingWithdrawal{}, err
+	}
+	withdrawal := types.PendingWithdrawal{
+		Id:             id,
+		Account:        deposit.Account,
+		Amount:         amount,
+		CompletionTime: sdk.UnwrapSDKContext(ctx).BlockTime().Add(params.UnbondingPeriod),
+	}
+	if err := k.PendingWithdrawals.Set(ctx, collections.Join(account, id), withdrawal); err != nil {
+		return types.PendingWithdrawal{}, err
+	}
+
+	return withdrawal, nil
+}
+
+// CompleteMatureWithdrawals pays out every pending withdrawal whose completion
+// time has passed.
+func (k Keeper) CompleteMatureWithdrawals(ctx sdk.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	var matured []collections.Pair[sdk.AccAddress, uint64]
+	err = k.PendingWithdrawals.Indexes.CompletionTime.Walk(ctx, nil,
+		func(completionTime time.Time, key collections.Pair[sdk.AccAddress, uint64]) (bool, error) {
+			if completionTime.After(ctx.BlockTime()) {
+				return true, nil
+			}
+			matured = append(matured, key)
+			return false, nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range matured {
+		withdrawal, err := k.PendingWithdrawals.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(params.Denom, math.NewIntFromUint64(withdrawal.Amount)))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, key.K1(), coins); err != nil {
+			return err
+		}
+		if err := k.PendingWithdrawals.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		ctx.Logger().Info("Paid out reclaimed trust deposit",
+			"account", withdrawal.Account,
+			"amount", coins.String())
+	}
+
+	return nil
+}
+
+// removeShares removes the shares worth value at shareValue from the trust
+// deposit of account, rounded up, and value from the trust deposit value.
+// principal is the part of value taken from the amount deposited. A lock above
+// the principal left, after a slash, is reduced to it.
+func (k Keeper) removeShares(
+	ctx context.Context,
+	shareValue math.LegacyDec,
+	account sdk.AccAddress,
+	deposit types.TrustDeposit,
+	value math.Int,
+	principal uint64,
+) error {
+	share := math.LegacyNewDecFromInt(value).QuoRoundUp(shareValue)
+	if share.GT(deposit.Share) {
+		// rounding the shares up may ask for slightly more shares than the
+		// deposit holds when its whole value is withdrawn
+		if deposit.Share.Mul(shareValue).Ceil().LT(math.LegacyNewDecFromInt(value)) {
+			return errorsmod.Wrapf(
+				types.ErrInsufficientTrustDeposit, "%s uvna needs %s shares, deposit holds %s", value, share, deposit.Share,
+			)
+		}
+		share = deposit.Share
+	}
+	trustDepositValue, err := k.GetTrustDepositValue(ctx)
+	if err != nil {
+		return err
+	}
+	if value.Uint64() > trustDepositValue {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientTrustDeposit, "%s uvna exceeds the trust deposit value %d", value, trustDepositValue,
+		)
+	}
+
+	deposit.Share = deposit.Share.Sub(share)
+	deposit.Amount -= principal
+	deposit.Locked = min(deposit.Locked, deposit.Amount)
+	if deposit.Share.IsZero() {
+		if err := k.TrustDeposits.Remove(ctx, account); err != nil {
+			return err
+		}
+	} else if err := k.TrustDeposits.Set(ctx, account, deposit); err != nil {
+		return err
+	}
+
+	totalShares, err := k.GetTotalShares(ctx)
+	if err != nil {
+		return err
+	}
+	totalShares = totalShares.Sub(share)
+	if totalShares.IsNegative() {
+		totalShares = math.LegacyZeroDec()
+	}
+	if err := k.TotalShares.Set(ctx, totalShares); err != nil {
+		return err
+	}
+
+	return k.TrustDepositValue.Set(ctx, trustDepositValue-value.Uint64())
+}
+
+// getTrustDeposit returns the trust deposit of account and the current share
+// value.
+func (k Keeper) getTrustDeposit(ctx context.Context, account sdk.AccAddress) (types.TrustDeposit, math.LegacyDec, error) {
+	shareValue, err := k.GetTrustDepositShareValue(ctx)
+	if err != nil {
+		return types.TrustDeposit{}, math.LegacyDec{}, err
+	}
+
+	deposit, err := k.TrustDeposits.Get(ctx, account)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.TrustDeposit{}, math.LegacyDec{}, errorsmod.Wrap(types.ErrTrustDepositNotFound, account.String())
+		}
+		return types.TrustDeposit{}, math.LegacyDec{}, err
+	}
+
+	return deposit, shareValue, nil
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"veranatest/testutil/sample"
+	"veranatest/x/td/keeper"
+	"veranatest/x/td/types"
+)
+
+// setupDeposit deposits 1000uvna for a new account at a share value of 1, then
+// adds 500uvna of yield, raising the share value to 1.5.
+func setupDeposit(t *testing.T, f *fixture) sdk.AccAddress {
+	t.Helper()
+
+	account := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	f.bankKeeper.fundModule(types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("uvna", 1500)))
+	require.NoError(t, f.keeper.Deposit(f.ctx, account, math.NewInt(1000)))
+	require.NoError(t, f.keeper.AddTrustDepositValue(f.ctx, math.NewInt(500)))
+
+	return account
+}
+
+func TestReclaimYield(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	alice := setupDeposit(t, f)
+
+	response, err := ms.ReclaimYield(f.ctx, &types.MsgReclaimYield{Creator: alice.String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(500), response.Amount)
+	require.Equal(t, int64(500), f.bankKeeper.GetAllBalances(f.ctx, alice).AmountOf("uvna").Int64())
+
+	// the principal is kept and the share value is unchanged
+	deposit, err := f.keeper.TrustDeposits.Get(f.ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), deposit.Amount)
+	shareValue, err := f.keeper.TrustDepositShareValue.Get(f.ctx)
+	require.NoError(t, err)
+	trustDepositValue, err := f.keeper.TrustDepositValue.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), trustDepositValue)
+	require.Equal(t, math.LegacyNewDecWithPrec(15, 1), shareValue)
+
+	_, err = ms.ReclaimYield(f.ctx, &types.MsgReclaimYield{Creator: alice.String()})
+	require.ErrorIs(t, err, types.ErrNoYield)
+
+	_, err = ms.ReclaimYield(f.ctx, &types.MsgReclaimYield{Creator: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrTrustDepositNotFound)
+
+	_, err = ms.ReclaimYield(f.ctx, &types.MsgReclaimYield{Creator: "invalid"})
+	require.Error(t, err)
+}
+
+func TestReclaimTrustDeposit(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	alice := setupDeposit(t, f)
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start)
+	unbonding := types.DefaultUnbondingPeriod
+
+	_, err := ms.ReclaimTrustDeposit(ctx, &types.MsgReclaimTrustDeposit{Creator: alice.String(), Amount: 0})
+	require.ErrorIs(t, err, types.ErrInvalidAmount)
+
+	first, err := ms.ReclaimTrustDeposit(ctx, &types.MsgReclaimTrustDeposit{Creator: alice.String(), Amount: 400})
+	require.NoError(t, err)
+	require.Equal(t, start.Add(unbonding), first.CompletionTime)
+
+	_, err = ms.ReclaimTrustDeposit(ctx, &types.MsgReclaimTrustDeposit{Creator: alice.String(), Amount: 700})
+	require.ErrorIs(t, err, types.ErrInsufficientTrustDeposit)
+
+	// the rest of the principal is reclaimed a day later; the yield is kept
+	ctx = ctx.WithBlockTime(start.Add(24 * time.Hour))
+	second, err := ms.ReclaimTrustDeposit(ctx, &types.MsgReclaimTrustDeposit{Creator: alice.String(), Amount: 600})
+	require.NoError(t, err)
+
+	deposit, err := f.keeper.TrustDeposits.Get(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), deposit.Amount)
+	require.Equal(t, math.NewInt(500), keeper.TrustDepositValue(deposit, math.LegacyNewDecWithPrec(15, 1)))
+
+	response, err := qs.PendingWithdrawals(ctx, &types.QueryPendingWithdrawalsRequest{Account: alice.String()})
+	require.NoError(t, err)
+	require.Equal(t, []types.PendingWithdrawal{
+		{Id: first.WithdrawalId, Account: alice.String(), Amount: 400, CompletionTime: first.CompletionTime},
+		{Id: second.WithdrawalId, Account: alice.String(), Amount: 600, CompletionTime: second.CompletionTime},
+	}, response.PendingWithdrawals)
+
+	// nothing is paid out before the unbonding period ends
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockTime(start.Add(unbonding-time.Second))))
+	require.True(t, f.bankKeeper.GetAllBalances(ctx, alice).IsZero())
+
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockTime(start.Add(unbonding))))
+	require.Equal(t, int64(400), f.bankKeeper.GetAllBalances(ctx, alice).AmountOf("uvna").Int64())
+
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockTime(start.Add(unbonding+24*time.Hour))))
+	require.Equal(t, int64(1000), f.bankKeeper.GetAllBalances(ctx, alice).AmountOf("uvna").Int64())
+
+	response, err = qs.PendingWithdrawals(ctx, &types.QueryPendingWithdrawalsRequest{Account: alice.String()})
+	require.NoError(t, err)
+	require.Empty(t, response.PendingWithdrawals)
+
+	// the yield can still be reclaimed, which removes the deposit
+	_, err = ms.ReclaimYield(ctx, &types.MsgReclaimYield{Creator: alice.String()})
+	require.NoError(t, err)
+	_, err = f.keeper.TrustDeposits.Get(ctx, alice)
+	require.Error(t, err)
+	require.Equal(t, int64(1500), f.bankKeeper.GetAllBalances(ctx, alice).AmountOf("uvna").Int64())
+}
+
+func TestReclaimTrustDepositAfterYieldReclaim(t *testing.T) {
+	f := initFixture(t)
+	alice := setupDeposit(t, f)
+
+	// reclaiming the yield rounds the removed shares up, so the remaining shares
+	// are worth slightly less than the principal
+	_, err := f.keeper.ReclaimYield(f.ctx, alice)
+	require.NoError(t, err)
+
+	_, err = f.keeper.ReclaimTrustDeposit(f.ctx, alice, 1000)
+	require.NoError(t, err)
+
+	_, err = f.keeper.TrustDeposits.Get(f.ctx, alice)
+	require.Error(t, err)
+	totalShares, err := f.keeper.GetTotalShares(f.ctx)
+	require.NoError(t, err)
+	require.True(t, totalShares.IsZero())
+}
+
+func TestPendingWithdrawalsQueryErrors(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	_, err := qs.PendingWithdrawals(f.ctx, nil)
+	require.Error(t, err)
+
+	_, err = qs.PendingWithdrawals(f.ctx, &types.QueryPendingWithdrawalsRequest{Account: "invalid"})
+	require.Error(t, err)
+}
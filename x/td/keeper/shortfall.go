@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"veranatest/x/td/types"
+)
+
+// GetYieldShortfall returns the record of the yield verana_pool could not pay
+func (k Keeper) GetYieldShortfall(ctx context.Context) (types.YieldShortfall, error) {
+	shortfall, err := k.YieldShortfall.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.YieldShortfall{}, nil
+		}
+		return types.YieldShortfall{}, err
+	}
+
+	return shortfall, nil
+}
+
+// updateYieldShortfall records paid, transferred for the shortfall and the
+// yield of the block. The shortfall is paid back first, then the part of yield
+// left unpaid is added to it.
+func (k Keeper) updateYieldShortfall(ctx sdk.Context, denom string, shortfall types.YieldShortfall, yield, paid math.Int) error {
+	if shortfall.Amount == 0 && paid.GTE(yield) {
+		return nil
+	}
+
+	repaid := math.MinInt(paid, math.NewIntFromUint64(shortfall.Amount))
+	if repaid.IsPositive() {
+		shortfall.Amount -= repaid.Uint64()
+		shortfall.TotalRepaid += repaid.Uint64()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"yield_shortfall_repaid",
+				sdk.NewAttribute("amount", sdk.NewCoin(denom, repaid).String()),
+				sdk.NewAttribute("remaining", sdk.NewCoin(denom, math.NewIntFromUint64(shortfall.Amount)).String()),
+			),
+		)
+	}
+
+	unpaid := yield.Sub(paid.Sub(repaid))
+	if unpaid.IsPositive() {
+		shortfall.Amount += unpaid.Uint64()
+		shortfall.TotalRecorded += unpaid.Uint64()
+		shortfall.LastShortfallHeight = ctx.BlockHeight()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"yield_shortfall",
+				sdk.NewAttribute("amount", sdk.NewCoin(denom, unpaid).String()),
+				sdk.NewAttribute("owed", sdk.NewCoin(denom, math.NewIntFromUint64(shortfall.Amount)).String()),
+			),
+		)
+		ctx.Logger().Info("Verana pool cannot pay the yield, recorded as shortfall",
+			"unpaid", unpaid.String(),
+			"owed", shortfall.Amount)
+	}
+
+	return k.YieldShortfall.Set(ctx, shortfall)
+}
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	protocolpooltypes "github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+
+	"veranatest/x/td/types"
+)
+
+// SlashTrustDeposit slashes fraction of the trust deposit of account and of its
+// pending withdrawals, rounded down. The slashed amount is burned, or sent to
+// the community pool if burn is false. The slash is recorded in the slash
+// history of account and the slash hooks are called with the amount slashed.
+func (k Keeper) SlashTrustDeposit(
+	ctx context.Context,
+	account sdk.AccAddress,
+	fraction math.LegacyDec,
+	burn bool,
+	reason string,
+) (math.Int, error) {
+	if fraction.IsNil() || !fraction.IsPositive() || fraction.GT(math.LegacyOneDec()) {
+		return math.Int{}, errorsmod.Wrapf(types.ErrInvalidFraction, "%s must be in (0, 1]", fraction)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	slashed := math.ZeroInt()
+	found := false
+
+	deposit, shareValue, err := k.getTrustDeposit(ctx, account)
+	switch {
+	case err == nil:
+		found = true
+		value := math.LegacyNewDecFromInt(TrustDepositValue(deposit, shareValue)).Mul(fraction).TruncateInt()
+		principal := math.LegacyNewDecFromInt(math.NewIntFromUint64(deposit.Amount)).Mul(fraction).TruncateInt().Uint64()
+		if value.IsPositive() {
+			if err := k.removeShares(ctx, shareValue, account, deposit, value, principal); err != nil {
+				return math.Int{}, err
+			}
+			slashed = slashed.Add(value)
+		}
+	case !errors.Is(err, types.ErrTrustDepositNotFound):
+		return math.Int{}, err
+	}
+
+	pending, foundPending, err := k.slashPendingWithdrawals(ctx, account, fraction)
+	if err != nil {
+		return math.Int{}, err
+	}
+	if !found && !foundPending {
+		return math.Int{}, errorsmod.Wrap(types.ErrTrustDepositNotFound, account.String())
+	}
+	slashed = slashed.Add(pending)
+
+	if slashed.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(params.Denom, slashed))
+		if burn {
+			err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+		} else {
+			err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, protocolpooltypes.ModuleName, coins)
+		}
+		if err != nil {
+			return math.Int{}, err
+		}
+	}
+
+	id, err := k.SlashSeq.Next(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+	accountStr, err := k.addressCodec.BytesToString(account)
+	if err != nil {
+		return math.Int{}, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	record := types.SlashRecord{
+		Id:       id,
+		Account:  accountStr,
+		Fraction: fraction,
+		Amount:   slashed.Uint64(),
+		Burned:   burn,
+		Reason:   reason,
+		Height:   sdkCtx.BlockHeight(),
+		Time:     sdkCtx.BlockTime(),
+	}
+	if err := k.SlashRecords.Set(ctx, collections.Join(account, id), record); err != nil {
+		return math.Int{}, err
+	}
+
+	if err := k.slashHooks.AfterTrustDepositSlashed(ctx, account, slashed); err != nil {
+		return math.Int{}, err
+	}
+
+	return slashed, nil
+}
+
+// slashPendingWithdrawals slashes fraction of each pending withdrawal of
+// account, rounded down, and removes the withdrawals left empty. It returns the
+// amount slashed and whether account has any pending withdrawal.
+func (k Keeper) slashPendingWithdrawals(ctx context.Context, account sdk.AccAddress, fraction math.LegacyDec) (math.Int, bool, error) {
+	iter, err := k.PendingWithdrawals.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, uint64](account))
+	if err != nil {
+		return math.Int{}, false, err
+	}
+	withdrawals, err := iter.KeyValues()
+	if err != nil {
+		return math.Int{}, false, err
+	}
+
+	slashed := math.ZeroInt()
+	for _, kv := range withdrawals {
+		withdrawal := kv.Value
+		amount := math.LegacyNewDecFromInt(math.NewIntFromUint64(withdrawal.Amount)).Mul(fraction).TruncateInt().Uint64()
+		if amount == 0 {
+			continue
+		}
+
+		withdrawal.Amount -= amount
+		if withdrawal.Amount == 0 {
+			err = k.PendingWithdrawals.Remove(ctx, kv.Key)
+		} else {
+			err = k.PendingWithdrawals.Set(ctx, kv.Key, withdrawal)
+		}
+		if err != nil {
+			return math.Int{}, false, err
+		}
+		slashed = slashed.Add(math.NewIntFromUint64(amount))
+	}
+
+	return slashed, len(withdrawals) > 0, nil
+}
@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	protocolpooltypes "github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+	"github.com/stretchr/testify/require"
+
+	"veranatest/testutil/sample"
+	"veranatest/x/td/keeper"
+	"veranatest/x/td/types"
+)
+
+// recordingSlashHook records the slashes it is called with.
+type recordingSlashHook struct {
+	slashes map[string]math.Int
+}
+
+func (h *recordingSlashHook) AfterTrustDepositSlashed(_ context.Context, account sdk.AccAddress, amount math.Int) error {
+	h.slashes[account.String()] = amount
+	return nil
+}
+
+func TestSlashTrustDeposit(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	authority := authtypes.NewModuleAddress(types.GovModuleName).String()
+	hook := &recordingSlashHook{slashes: make(map[string]math.Int)}
+	f.keeper.SetSlashHooks(hook)
+	alice := setupDeposit(t, f)
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now).WithBlockHeight(10)
+
+	// a tenth of the 1500uvna deposit value is burned
+	response, err := ms.SlashTrustDeposit(ctx, &types.MsgSlashTrustDeposit{
+		Authority: authority,
+		Account:   alice.String(),
+		Fraction:  math.LegacyNewDecWithPrec(1, 1),
+		Burn:      true,
+		Reason:    "double signing",
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(150), response.Amount)
+	require.Equal(t, int64(1350), f.bankKeeper.moduleBalance(types.ModuleName).AmountOf("uvna").Int64())
+	require.Equal(t, int64(150), hook.slashes[alice.String()].Int64())
+
+	deposit, err := f.keeper.TrustDeposits.Get(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, uint64(900), deposit.Amount)
+	shareValue, err := f.keeper.TrustDepositShareValue.Get(ctx)
+	require.NoError(t, err)
+	trustDepositValue, err := f.keeper.TrustDepositValue.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1350), trustDepositValue)
+	require.Equal(t, math.LegacyNewDecWithPrec(15, 1), shareValue)
+
+	// half of the deposit and of the pending withdrawals go to the community pool
+	_, err = f.keeper.ReclaimTrustDeposit(ctx, alice, 300)
+	require.NoError(t, err)
+	response, err = ms.SlashTrustDeposit(ctx, &types.MsgSlashTrustDeposit{
+		Authority: authority,
+		Account:   alice.String(),
+		Fraction:  math.LegacyNewDecWithPrec(5, 1),
+		Reason:    "downtime",
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(525+150), response.Amount)
+	require.Equal(t, int64(675), f.bankKeeper.moduleBalance(protocolpooltypes.ModuleName).AmountOf("uvna").Int64())
+
+	withdrawals, err := qs.PendingWithdrawals(ctx, &types.QueryPendingWithdrawalsRequest{Account: alice.String()})
+	require.NoError(t, err)
+	require.Len(t, withdrawals.PendingWithdrawals, 1)
+	require.Equal(t, uint64(150), withdrawals.PendingWithdrawals[0].Amount)
+
+	history, err := qs.SlashHistory(ctx, &types.QuerySlashHistoryRequest{Account: alice.String()})
+	require.NoError(t, err)
+	require.Len(t, history.SlashRecords, 2)
+	require.Equal(t, uint64(150), history.SlashRecords[0].Amount)
+	require.True(t, history.SlashRecords[0].Burned)
+	require.Equal(t, "double signing", history.SlashRecords[0].Reason)
+	require.Equal(t, int64(10), history.SlashRecords[0].Height)
+	require.Equal(t, now, history.SlashRecords[0].Time)
+	require.Equal(t, uint64(675), history.SlashRecords[1].Amount)
+	require.False(t, history.SlashRecords[1].Burned)
+
+	// a whole slash removes the deposit
+	_, err = f.keeper.SlashTrustDeposit(ctx, alice, math.LegacyOneDec(), true, "")
+	require.NoError(t, err)
+	_, err = f.keeper.TrustDeposits.Get(ctx, alice)
+	require.Error(t, err)
+	withdrawals, err = qs.PendingWithdrawals(ctx, &types.QueryPendingWithdrawalsRequest{Account: alice.String()})
+	require.NoError(t, err)
+	require.Empty(t, withdrawals.PendingWithdrawals)
+	require.True(t, f.bankKeeper.moduleBalance(types.ModuleName).IsZero())
+}
+
+func TestSlashTrustDepositErrors(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	authority := authtypes.NewModuleAddress(types.GovModuleName).String()
+	alice := setupDeposit(t, f)
+
+	_, err := ms.SlashTrustDeposit(f.ctx, &types.MsgSlashTrustDeposit{
+		Authority: sample.AccAddress(),
+		Account:   alice.String(),
+		Fraction:  math.LegacyNewDecWithPrec(1, 1),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	for _, fraction := range []math.LegacyDec{math.LegacyZeroDec(), math.LegacyNewDec(-1), math.LegacyNewDecWithPrec(11, 1)} {
+		_, err = ms.SlashTrustDeposit(f.ctx, &types.MsgSlashTrustDeposit{
+			Authority: authority,
+			Account:   alice.String(),
+			Fraction:  fraction,
+		})
+		require.ErrorIs(t, err, types.ErrInvalidFraction)
+	}
+
+	_, err = ms.SlashTrustDeposit(f.ctx, &types.MsgSlashTrustDeposit{
+		Authority: authority,
+		Account:   sample.AccAddress(),
+		Fraction:  math.LegacyNewDecWithPrec(1, 1),
+	})
+	require.ErrorIs(t, err, types.ErrTrustDepositNotFound)
+
+	require.Panics(t, func() {
+		f.keeper.SetSlashHooks(&recordingSlashHook{}, &recordingSlashHook{})
+		f.keeper.SetSlashHooks(&recordingSlashHook{})
+	})
+}
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"veranatest/x/td/types"
+)
+
+// Deposit adds amount uvna, already sent to the module account, to the trust
+// deposit of account. The account receives amount / trust deposit share value
+// shares, rounded down.
+func (k Keeper) Deposit(ctx context.Context, account sdk.AccAddress, amount math.Int) error {
+	shareValue, err := k.GetTrustDepositShareValue(ctx)
+	if err != nil {
+		return err
+	}
+
+	share := math.LegacyNewDecFromInt(amount).QuoTruncate(shareValue)
+
+	deposit, err := k.TrustDeposits.Get(ctx, account)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		accountStr, err := k.addressCodec.BytesToString(account)
+		if err != nil {
+			return err
+		}
+		deposit = types.TrustDeposit{Account: accountStr, Share: math.LegacyZeroDec()}
+	}
+	deposit.Amount += amount.Uint64()
+	deposit.Share = deposit.Share.Add(share)
+	if err := k.TrustDeposits.Set(ctx, account, deposit); err != nil {
+		return err
+	}
+
+	totalShares, err := k.GetTotalShares(ctx)
+	if err != nil {
+		return err
+	}
+	if err := k.TotalShares.Set(ctx, totalShares.Add(share)); err != nil {
+		return err
+	}
+
+	trustDepositValue, err := k.GetTrustDepositValue(ctx)
+	if err != nil {
+		return err
+	}
+	return k.TrustDepositValue.Set(ctx, trustDepositValue+amount.Uint64())
+}
+
+// AddTrustDepositValue adds amount uvna, already sent to the module account, to
+// the trust deposit value without issuing shares. This raises the share value
+// of all trust deposits, and is used for yield and fee-sourced funding. With no
+// shares, amount is not added, as later deposits are issued shares at the
+// current share value and could not claim it. It stays in the module account.
+func (k Keeper) AddTrustDepositValue(ctx context.Context, amount math.Int) error {
+	totalShares, err := k.GetTotalShares(ctx)
+	if err != nil {
+		return err
+	}
+	if !totalShares.IsPositive() {
+		return nil
+	}
+
+	trustDepositValue, err := k.GetTrustDepositValue(ctx)
+	if err != nil {
+		return err
+	}
+	trustDepositValue += amount.Uint64()
+	if err := k.TrustDepositValue.Set(ctx, trustDepositValue); err != nil {
+		return err
+	}
+
+	shareValue := math.LegacyNewDecFromInt(math.NewIntFromUint64(trustDepositValue)).QuoTruncate(totalShares)
+	return k.TrustDepositShareValue.Set(ctx, shareValue)
+}
+
+// GetTrustDepositShareValue returns the value of one share in uvna, 1 until
+// yield or fee-sourced funding is first added
+func (k Keeper) GetTrustDepositShareValue(ctx context.Context) (math.LegacyDec, error) {
+	shareValue, err := k.TrustDepositShareValue.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.DefaultTrustDepositShareValue(), nil
+		}
+		return math.LegacyDec{}, err
+	}
+	if shareValue.IsNil() || !shareValue.IsPositive() {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidShareValue, "%s", shareValue)
+	}
+
+	return shareValue, nil
+}
+
+// GetTrustDepositValue returns the value of all trust deposits in uvna
+func (k Keeper) GetTrustDepositValue(ctx context.Context) (uint64, error) {
+	trustDepositValue, err := k.TrustDepositValue.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return trustDepositValue, nil
+}
+
+// GetTotalShares returns the sum of the shares of all trust deposits
+func (k Keeper) GetTotalShares(ctx context.Context) (math.LegacyDec, error) {
+	totalShares, err := k.TotalShares.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return math.LegacyZeroDec(), nil
+		}
+		return math.LegacyDec{}, err
+	}
+
+	return totalShares, nil
+}
+
+// TrustDepositValue returns the value of deposit in uvna at the given share
+// value, rounded down
+func TrustDepositValue(deposit types.TrustDeposit, shareValue math.LegacyDec) math.Int {
+	return deposit.Share.Mul(shareValue).TruncateInt()
+}
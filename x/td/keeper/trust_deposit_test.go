@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"veranatest/testutil/sample"
+	"veranatest/x/td/keeper"
+	"veranatest/x/td/types"
+)
+
+func TestTrustDepositShares(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	f.bankKeeper.balances[alice.String()] = sdk.NewCoins(sdk.NewInt64Coin("uvna", 10_000))
+	f.bankKeeper.balances[bob.String()] = sdk.NewCoins(sdk.NewInt64Coin("uvna", 10_000))
+
+	// alice deposits at the default share value of 1
+	_, err := ms.FundModule(f.ctx, &types.MsgFundModule{Creator: alice.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("uvna", 1000)), Module: types.ModuleName})
+	require.NoError(t, err)
+
+	// yield raises the share value to 1.5
+	f.bankKeeper.fundModule(types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("uvna", 500)))
+	require.NoError(t, f.keeper.AddTrustDepositValue(f.ctx, math.NewInt(500)))
+
+	// bob deposits at a share value of 1.5
+	_, err = ms.FundModule(f.ctx, &types.MsgFundModule{Creator: bob.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("uvna", 300)), Module: types.ModuleName})
+	require.NoError(t, err)
+
+	shareValue, err := f.keeper.TrustDepositShareValue.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(15, 1), shareValue)
+	trustDepositValue, err := f.keeper.TrustDepositValue.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1800), trustDepositValue)
+
+	totalShares, err := f.keeper.GetTotalShares(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(1200), totalShares)
+
+	testCases := []struct {
+		account  sdk.AccAddress
+		expected types.TrustDeposit
+		expValue uint64
+	}{
+		{account: alice, expected: types.TrustDeposit{Account: alice.String(), Amount: 1000, Share: math.LegacyNewDec(1000)}, expValue: 1500},
+		{account: bob, expected: types.TrustDeposit{Account: bob.String(), Amount: 300, Share: math.LegacyNewDec(200)}, expValue: 300},
+	}
+	for _, tc := range testCases {
+		response, err := qs.TrustDeposit(f.ctx, &types.QueryTrustDepositRequest{Account: tc.account.String()})
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, response.TrustDeposit)
+		require.Equal(t, tc.expValue, response.Value)
+		require.Equal(t, shareValue, response.ShareValue)
+	}
+
+	// funding another module is not a trust deposit
+	_, err = ms.FundModule(f.ctx, &types.MsgFundModule{Creator: alice.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("uvna", 100)), Module: types.VeranaPoolAccount})
+	require.NoError(t, err)
+	deposit, err := f.keeper.TrustDeposits.Get(f.ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), deposit.Amount)
+}
+
+func TestDepositRoundsSharesDown(t *testing.T) {
+	f := initFixture(t)
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	shareValue := math.LegacyNewDec(3)
+	require.NoError(t, f.keeper.TrustDepositShareValue.Set(f.ctx, shareValue))
+
+	require.NoError(t, f.keeper.Deposit(f.ctx, alice, math.NewInt(100)))
+
+	deposit, err := f.keeper.TrustDeposits.Get(f.ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyMustNewDecFromStr("33.333333333333333333"), deposit.Share)
+	require.Equal(t, math.NewInt(99), keeper.TrustDepositValue(deposit, shareValue))
+}
+
+func TestDepositInvalidShareValue(t *testing.T) {
+	f := initFixture(t)
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	require.NoError(t, f.keeper.TrustDepositShareValue.Set(f.ctx, math.LegacyZeroDec()))
+
+	err := f.keeper.Deposit(f.ctx, alice, math.NewInt(100))
+	require.ErrorIs(t, err, types.ErrInvalidShareValue)
+}
+
+func TestAddTrustDepositValueWithoutShares(t *testing.T) {
+	f := initFixture(t)
+	alice := sdk.AccAddress("alice")
+
+	// with no trust deposits nothing is added, as no deposit could claim it
+	require.NoError(t, f.keeper.AddTrustDepositValue(f.ctx, math.NewInt(500)))
+
+	trustDepositValue, err := f.keeper.GetTrustDepositValue(f.ctx)
+	require.NoError(t, err)
+	require.Zero(t, trustDepositValue)
+
+	shareValue, err := f.keeper.GetTrustDepositShareValue(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultTrustDepositShareValue(), shareValue)
+
+	// the first deposit is worth what was deposited, and later value goes to it
+	require.NoError(t, f.keeper.Deposit(f.ctx, alice, math.NewInt(1000)))
+	require.NoError(t, f.keeper.AddTrustDepositValue(f.ctx, math.NewInt(100)))
+
+	deposit, err := f.keeper.TrustDeposits.Get(f.ctx, alice)
+	require.NoError(t, err)
+	shareValue, err = f.keeper.GetTrustDepositShareValue(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1100), keeper.TrustDepositValue(deposit, shareValue))
+
+	trustDepositValue, err = f.keeper.GetTrustDepositValue(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1100), trustDepositValue)
+}
+
+func TestTrustDepositQueryErrors(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	_, err := qs.TrustDeposit(f.ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = qs.TrustDeposit(f.ctx, &types.QueryTrustDepositRequest{Account: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = qs.TrustDeposit(f.ctx, &types.QueryTrustDepositRequest{Account: sample.AccAddress()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"veranatest/x/td/types"
+)
+
+// GetYieldCircuit returns the failures of the yield transfers and sweeps
+func (k Keeper) GetYieldCircuit(ctx context.Context) (types.YieldCircuit, error) {
+	circuit, err := k.YieldCircuit.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.YieldCircuit{}, nil
+		}
+		return types.YieldCircuit{}, err
+	}
+
+	return circuit, nil
+}
+
+// runYieldFlow runs flow, a yield transfer or sweep of verana_pool, in a cached
+// context, so that a failure is discarded instead of halting the chain. Each
+// failure is logged and counted, and flows are paused once yield_failure_limit
+// consecutive flows failed. Paused flows are skipped until ResumeYield. When a
+// failed flow accrues yield, the yield it missed is recorded as a shortfall.
+func (k Keeper) runYieldFlow(ctx sdk.Context, params types.Params, accrues bool, flow func(sdk.Context) error) error {
+	circuit, err := k.GetYieldCircuit(ctx)
+	if err != nil {
+		return err
+	}
+	if circuit.Paused {
+		return nil
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	flowErr := flow(cacheCtx)
+	if flowErr == nil {
+		write()
+		if circuit.ConsecutiveFailures == 0 {
+			return nil
+		}
+		circuit.ConsecutiveFailures = 0
+		return k.YieldCircuit.Set(ctx, circuit)
+	}
+
+	circuit.ConsecutiveFailures++
+	circuit.LastFailureHeight = ctx.BlockHeight()
+	circuit.LastError = flowErr.Error()
+
+	ctx.Logger().Error("Failed to move verana pool funds",
+		"error", flowErr,
+		"consecutive_failures", circuit.ConsecutiveFailures)
+
+	// The discarded flow did not record its accrual, and the next flow accrues
+	// at most max_accrual_duration, so the yield missed is owed as a shortfall
+	if accrues {
+		cacheCtx, write := ctx.CacheContext()
+		if missed, err := k.accruePausedYield(cacheCtx, params); err != nil {
+			ctx.Logger().Error("Failed to record the yield missed by a failed transfer", "error", err)
+		} else {
+			write()
+			ctx.Logger().Info("Recorded the yield missed by a failed transfer as a shortfall",
+				"amount", sdk.NewCoin(params.Denom, missed).String())
+		}
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"yield_flow_failed",
+			sdk.NewAttribute("error", flowErr.Error()),
+			sdk.NewAttribute("consecutive_failures", fmt.Sprint(circuit.ConsecutiveFailures)),
+		),
+	)
+
+	if params.YieldFailureLimit > 0 && circuit.ConsecutiveFailures >= params.YieldFailureLimit {
+		circuit.Paused = true
+
+		ctx.Logger().Error("Paused verana pool fund flows",
+			"consecutive_failures", circuit.ConsecutiveFailures)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"yield_paused",
+				sdk.NewAttribute("consecutive_failures", fmt.Sprint(circuit.ConsecutiveFailures)),
+			),
+		)
+	}
+
+	return k.YieldCircuit.Set(ctx, circuit)
+}
+
+// ResumeYield resumes the yield transfers and sweeps paused after
+// yield_failure_limit consecutive failures. The yield accrued while paused is
+// owed in full: it is recorded as a shortfall, paid back first by the next
+// transfers.
+func (k Keeper) ResumeYield(ctx context.Context) error {
+	circuit, err := k.GetYieldCircuit(ctx)
+	if err != nil {
+		return err
+	}
+	if !circuit.Paused {
+		return types.ErrYieldNotPaused
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	accrued, err := k.accruePausedYield(sdkCtx, params)
+	if err != nil {
+		return err
+	}
+
+	circuit.Paused = false
+	circuit.ConsecutiveFailures = 0
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"yield_resumed",
+			sdk.NewAttribute("accrued", sdk.NewCoin(params.Denom, accrued).String()),
+		),
+	)
+
+	return k.YieldCircuit.Set(ctx, circuit)
+}
+
+// accruePausedYield accrues the yield since the last accrual, without the
+// max_accrual_duration cap, and records it as a shortfall. Nothing is accrued
+// if yield never accrued. It is used for the yield missed while yield is paused
+// and by failed transfers.
+func (k Keeper) accruePausedYield(ctx sdk.Context, params types.Params) (math.Int, error) {
+	accrual, err := k.YieldAccrual.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return math.ZeroInt(), nil
+		}
+		return math.Int{}, err
+	}
+
+	accruedYield, err := k.AccrueYield(ctx, params, ctx.BlockTime().Sub(accrual.LastAccrualTime))
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	// Keep what is below 1 micro unit as dust, as SendFundsFromVeranaPool does
+	currentDust, err := k.GetDustAmount(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+	totalAmount := currentDust.Add(accruedYield)
+	yieldAmount := totalAmount.TruncateInt()
+	if err := k.SetDustAmount(ctx, totalAmount.Sub(math.LegacyNewDecFromInt(yieldAmount))); err != nil {
+		return math.Int{}, err
+	}
+	if !yieldAmount.IsPositive() {
+		return yieldAmount, nil
+	}
+
+	shortfall, err := k.GetYieldShortfall(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+	if err := k.updateYieldShortfall(ctx, params.Denom, shortfall, yieldAmount, math.ZeroInt()); err != nil {
+		return math.Int{}, err
+	}
+
+	return yieldAmount, nil
+}
@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"veranatest/testutil/sample"
+	"veranatest/x/td/keeper"
+	"veranatest/x/td/types"
+)
+
+func TestYieldCircuit(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// 210,384,000uvna at 15% is 31,557,600uvna a year, 1uvna per second
+	require.NoError(t, f.keeper.TrustDepositValue.Set(f.ctx, 210_384_000))
+	f.bankKeeper.fundModule(types.VeranaPoolAccount, sdk.NewCoins(sdk.NewInt64Coin("uvna", 1000)))
+
+	_, err = ms.ResumeYield(f.ctx, &types.MsgResumeYield{Authority: authority})
+	require.ErrorIs(t, err, types.ErrYieldNotPaused)
+
+	// the first accrual fails without blocks per year, which does not halt the
+	// chain and leaves no state behind
+	f.mintKeeper.params.BlocksPerYear = 0
+	for height := int64(1); height <= types.DefaultYieldFailureLimit; height++ {
+		ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start).WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, f.keeper.BeginBlocker(ctx))
+		requireEvent(t, ctx, "yield_flow_failed", map[string]string{
+			"error":                "mint blocks per year must be positive",
+			"consecutive_failures": strconv.FormatInt(height, 10),
+		})
+	}
+	_, err = f.keeper.YieldAccrual.Get(f.ctx)
+	require.Error(t, err)
+
+	circuit, err := f.keeper.GetYieldCircuit(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.YieldCircuit{
+		ConsecutiveFailures: 3,
+		Paused:              true,
+		LastFailureHeight:   3,
+		LastError:           "mint blocks per year must be positive",
+	}, circuit)
+
+	// paused flows are skipped, even once they would succeed
+	f.mintKeeper.params.BlocksPerYear = 6_311_520
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start).WithBlockHeight(4)
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	require.True(t, f.bankKeeper.moduleBalance(types.ModuleName).IsZero())
+
+	_, err = ms.ResumeYield(f.ctx, &types.MsgResumeYield{Authority: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.ResumeYield(f.ctx, &types.MsgResumeYield{Authority: authority})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(5)
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	require.Equal(t, int64(5), f.bankKeeper.moduleBalance(types.ModuleName).AmountOf("uvna").Int64())
+
+	circuit, err = f.keeper.GetYieldCircuit(f.ctx)
+	require.NoError(t, err)
+	require.False(t, circuit.Paused)
+	require.Zero(t, circuit.ConsecutiveFailures)
+}
+
+func TestYieldCircuitResetOnSuccess(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.YieldFailureLimit = 2
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	// a success between two failures resets the count
+	f.mintKeeper.params.BlocksPerYear = 0
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	f.mintKeeper.params.BlocksPerYear = 6_311_520
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	require.NoError(t, f.keeper.YieldAccrual.Remove(ctx))
+	f.mintKeeper.params.BlocksPerYear = 0
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+
+	circuit, err := f.keeper.GetYieldCircuit(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), circuit.ConsecutiveFailures)
+	require.False(t, circuit.Paused)
+
+	// a zero limit never pauses
+	params.YieldFailureLimit = 0
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	for range 5 {
+		require.NoError(t, f.keeper.BeginBlocker(ctx))
+	}
+	circuit, err = f.keeper.GetYieldCircuit(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint32(6), circuit.ConsecutiveFailures)
+	require.False(t, circuit.Paused)
+}
+
+func TestYieldFlowFailureRecordsMissedYield(t *testing.T) {
+	f := initFixture(t)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// 210,384,000uvna at 15% is 31,557,600uvna a year, 1uvna per second
+	require.NoError(t, f.keeper.TrustDepositValue.Set(f.ctx, 210_384_000))
+	require.NoError(t, f.keeper.TotalShares.Set(f.ctx, math.LegacyNewDec(210_384_000)))
+	require.NoError(t, f.keeper.YieldAccrual.Set(f.ctx, types.YieldAccrual{LastAccrualTime: start}))
+	f.bankKeeper.fundModule(types.VeranaPoolAccount, sdk.NewCoins(sdk.NewInt64Coin("uvna", 10_000)))
+
+	// each failed transfer records the 30 seconds of yield it missed
+	f.bankKeeper.sendErr = errors.New("send failed")
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	for i := 1; i <= 2; i++ {
+		ctx = ctx.WithBlockTime(start.Add(time.Duration(i) * 30 * time.Second)).WithBlockHeight(int64(i))
+		require.NoError(t, f.keeper.BeginBlocker(ctx))
+
+		shortfall, err := f.keeper.GetYieldShortfall(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(i*30), shortfall.Amount)
+	}
+	require.True(t, f.bankKeeper.moduleBalance(types.ModuleName).IsZero())
+
+	// the next transfer pays the 60 seconds missed and its own 30 seconds, above
+	// max_accrual_duration
+	f.bankKeeper.sendErr = nil
+	ctx = ctx.WithBlockTime(start.Add(90 * time.Second)).WithBlockHeight(3)
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	require.Equal(t, int64(90), f.bankKeeper.moduleBalance(types.ModuleName).AmountOf("uvna").Int64())
+
+	shortfall, err := f.keeper.GetYieldShortfall(ctx)
+	require.NoError(t, err)
+	require.Zero(t, shortfall.Amount)
+	circuit, err := f.keeper.GetYieldCircuit(ctx)
+	require.NoError(t, err)
+	require.Zero(t, circuit.ConsecutiveFailures)
+}
+
+func TestResumeYieldAccruesPausedYield(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// 210,384,000uvna at 15% is 31,557,600uvna a year, 1uvna per second
+	require.NoError(t, f.keeper.TrustDepositValue.Set(f.ctx, 210_384_000))
+	require.NoError(t, f.keeper.TotalShares.Set(f.ctx, math.LegacyNewDec(210_384_000)))
+	f.mintKeeper.params.BlocksPerYear = 6_311_520
+	require.NoError(t, f.keeper.YieldAccrual.Set(f.ctx, types.YieldAccrual{LastAccrualTime: start}))
+	require.NoError(t, f.keeper.YieldCircuit.Set(f.ctx, types.YieldCircuit{ConsecutiveFailures: 3, Paused: true}))
+
+	// no yield moves for the hour yield is paused
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start.Add(30 * time.Minute)).WithBlockHeight(2)
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	require.True(t, f.bankKeeper.moduleBalance(types.ModuleName).IsZero())
+
+	// the hour, above max_accrual_duration, is owed in full
+	ctx = ctx.WithBlockTime(start.Add(time.Hour)).WithBlockHeight(3).WithEventManager(sdk.NewEventManager())
+	_, err = ms.ResumeYield(ctx, &types.MsgResumeYield{Authority: authority})
+	require.NoError(t, err)
+	requireEvent(t, ctx, "yield_resumed", map[string]string{"accrued": "3600uvna"})
+	shortfall, err := f.keeper.GetYieldShortfall(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3600), shortfall.Amount)
+
+	// the next block pays the shortfall and its own yield
+	f.bankKeeper.fundModule(types.VeranaPoolAccount, sdk.NewCoins(sdk.NewInt64Coin("uvna", 10_000)))
+	ctx = ctx.WithBlockTime(start.Add(time.Hour + 5*time.Second)).WithBlockHeight(4)
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	require.Equal(t, int64(3605), f.bankKeeper.moduleBalance(types.ModuleName).AmountOf("uvna").Int64())
+	shortfall, err = f.keeper.GetYieldShortfall(ctx)
+	require.NoError(t, err)
+	require.Zero(t, shortfall.Amount)
+	trustDepositValue, err := f.keeper.GetTrustDepositValue(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(210_384_000+3605), trustDepositValue)
+}
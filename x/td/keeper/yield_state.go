@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"veranatest/x/td/types"
+)
+
+// GetYieldDistribution returns the record of the yield moved from verana_pool
+// to the module
+func (k Keeper) GetYieldDistribution(ctx context.Context) (types.YieldDistribution, error) {
+	distribution, err := k.YieldDistribution.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.YieldDistribution{ValueTime: math.LegacyZeroDec()}, nil
+		}
+		return types.YieldDistribution{}, err
+	}
+
+	return distribution, nil
+}
+
+// ProjectedYield returns the yield the current trust deposit value earns over
+// duration at the current yield rate, without compounding.
+func (k Keeper) ProjectedYield(ctx context.Context, duration time.Duration) (math.LegacyDec, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	trustDepositValue, err := k.GetTrustDepositValue(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return projectYield(trustDepositValue, params.TrustDepositYieldRate, duration), nil
+}
+
+// EffectiveAPR returns the annual yield rate actually paid: the yield moved to
+// the module divided by the trust deposit value integrated over the time yield
+// accrued for. Yield skipped because verana_pool could not pay it lowers the
+// effective APR below the yield rate.
+func (k Keeper) EffectiveAPR(ctx context.Context) (math.LegacyDec, error) {
+	distribution, err := k.GetYieldDistribution(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if !distribution.ValueTime.IsPositive() {
+		return math.LegacyZeroDec(), nil
+	}
+
+	return math.LegacyNewDecFromInt(math.NewIntFromUint64(distribution.TotalTransferred)).
+		MulInt64(int64(types.Year / time.Second)).
+		Quo(distribution.ValueTime), nil
+}
+
+// recordYieldTransfer records amount of yield moved to the module in the
+// current block.
+func (k Keeper) recordYieldTransfer(ctx sdk.Context, amount math.Int) error {
+	distribution, err := k.GetYieldDistribution(ctx)
+	if err != nil {
+		return err
+	}
+
+	distribution.TotalTransferred += amount.Uint64()
+	distribution.LastTransferAmount = amount.Uint64()
+	distribution.LastTransferHeight = ctx.BlockHeight()
+	distribution.LastTransferTime = ctx.BlockTime()
+
+	return k.YieldDistribution.Set(ctx, distribution)
+}
+
+// addValueTime adds the trust deposit value accruing yield for elapsed to the
+// integrated value used by EffectiveAPR.
+func (k Keeper) addValueTime(ctx context.Context, trustDepositValue uint64, elapsed time.Duration) error {
+	distribution, err := k.GetYieldDistribution(ctx)
+	if err != nil {
+		return err
+	}
+
+	valueTime := math.LegacyNewDecFromInt(math.NewIntFromUint64(trustDepositValue)).
+		MulInt64(elapsed.Nanoseconds()).
+		QuoInt64(int64(time.Second))
+	distribution.ValueTime = distribution.ValueTime.Add(valueTime)
+
+	return k.YieldDistribution.Set(ctx, distribution)
+}
+
+// projectYield returns the yield trustDepositValue earns over duration at
+// yieldRate: trust_deposit_value * yield_rate * duration / year.
+func projectYield(trustDepositValue uint64, yieldRate math.LegacyDec, duration time.Duration) math.LegacyDec {
+	annualYield := math.LegacyNewDecFromInt(math.NewIntFromUint64(trustDepositValue)).Mul(yieldRate)
+
+	return annualYield.MulInt64(duration.Nanoseconds()).QuoInt64(int64(types.Year))
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"veranatest/x/td/keeper"
+	"veranatest/x/td/types"
+)
+
+func TestYieldQueries(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// 210,384,000uvna at 15% is 1uvna per second, 5uvna per 5 second block
+	require.NoError(t, f.keeper.TrustDepositValue.Set(f.ctx, 210_384_000))
+	f.bankKeeper.fundModule(types.VeranaPoolAccount, sdk.NewCoins(sdk.NewInt64Coin("uvna", 1000)))
+
+	state, err := qs.YieldState(f.ctx, &types.QueryYieldStateRequest{})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyOneDec(), state.YieldPerSecond)
+	require.Equal(t, math.LegacyNewDec(5), state.YieldPerBlock)
+	require.Zero(t, state.TotalTransferred)
+
+	projected, err := qs.ProjectedYield(f.ctx, &types.QueryProjectedYieldRequest{Duration: 30 * 24 * time.Hour})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(2_592_000), projected.Yield)
+
+	apr, err := qs.EffectiveAPR(f.ctx, &types.QueryEffectiveAPRRequest{})
+	require.NoError(t, err)
+	require.True(t, apr.Apr.IsZero())
+
+	// the first block accrues one block interval, the second 10 seconds
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start).WithBlockHeight(1)
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	f.bankKeeper.fundModule(types.VeranaPoolAccount, sdk.NewCoins(sdk.NewInt64Coin("uvna", 1000)))
+	ctx = ctx.WithBlockTime(start.Add(10 * time.Second)).WithBlockHeight(2)
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+
+	state, err = qs.YieldState(ctx, &types.QueryYieldStateRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(15), state.TotalTransferred)
+	require.Equal(t, uint64(10), state.LastTransferAmount)
+	require.Equal(t, int64(2), state.LastTransferHeight)
+	require.Equal(t, start.Add(10*time.Second), state.LastTransferTime)
+
+	dust, err := qs.DustAmount(ctx, &types.QueryDustAmountRequest{})
+	require.NoError(t, err)
+	require.True(t, dust.Dust.LT(math.LegacyOneDec()))
+
+	balances, err := qs.ModuleBalances(ctx, &types.QueryModuleBalancesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uvna", 15)), balances.Td)
+	require.True(t, balances.VeranaPool.IsZero())
+
+	// all accrued yield was paid, so the effective APR is the yield rate, less
+	// the dust not moved yet
+	apr, err = qs.EffectiveAPR(ctx, &types.QueryEffectiveAPRRequest{})
+	require.NoError(t, err)
+	require.True(t, apr.Apr.Sub(math.LegacyNewDecWithPrec(15, 2)).Abs().LT(math.LegacyNewDecWithPrec(1, 3)), apr.Apr)
+
+	// yield skipped by an empty pool lowers the effective APR
+	ctx = ctx.WithBlockTime(start.Add(25 * time.Second)).WithBlockHeight(3)
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	apr, err = qs.EffectiveAPR(ctx, &types.QueryEffectiveAPRRequest{})
+	require.NoError(t, err)
+	require.True(t, apr.Apr.LT(math.LegacyNewDecWithPrec(10, 2)), apr.Apr)
+}
+
+func TestYieldQueriesErrors(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	_, err := qs.ProjectedYield(f.ctx, &types.QueryProjectedYieldRequest{Duration: -time.Second})
+	require.Error(t, err)
+
+	_, err = qs.YieldState(f.ctx, nil)
+	require.Error(t, err)
+	_, err = qs.DustAmount(f.ctx, nil)
+	require.Error(t, err)
+	_, err = qs.ModuleBalances(f.ctx, nil)
+	require.Error(t, err)
+	_, err = qs.ProjectedYield(f.ctx, nil)
+	require.Error(t, err)
+	_, err = qs.EffectiveAPR(f.ctx, nil)
+	require.Error(t, err)
+}
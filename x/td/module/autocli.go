@@ -17,6 +17,71 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod: "FeeSourcedFunding",
+					Use:       "fee-sourced-funding",
+					Short:     "Shows the total transaction fees moved to the trust deposit module",
+				},
+				{
+					RpcMethod:      "TrustDeposit",
+					Use:            "trust-deposit [account]",
+					Short:          "Shows the trust deposit of an account and its current value",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "account"}},
+				},
+				{
+					RpcMethod:      "PendingWithdrawals",
+					Use:            "pending-withdrawals [account]",
+					Short:          "Shows the reclaimed trust deposits of an account that are not paid out yet",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "account"}},
+				},
+				{
+					RpcMethod:      "SlashHistory",
+					Use:            "slash-history [account]",
+					Short:          "Shows the slashes of the trust deposit of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "account"}},
+				},
+				{
+					RpcMethod: "DustAmount",
+					Use:       "dust-amount",
+					Short:     "Shows the accrued yield not yet moved to the trust deposit module",
+				},
+				{
+					RpcMethod: "ModuleBalances",
+					Use:       "module-balances",
+					Short:     "Shows the balances of the td and verana_pool module accounts",
+				},
+				{
+					RpcMethod: "YieldState",
+					Use:       "yield-state",
+					Short:     "Shows the current yield per second and per block, and the yield moved so far",
+				},
+				{
+					RpcMethod:      "ProjectedYield",
+					Use:            "projected-yield [duration]",
+					Short:          "Shows the yield the trust deposits earn over a duration at the current rate",
+					Example:        "veranatestd query td projected-yield 720h",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "duration"}},
+				},
+				{
+					RpcMethod: "EffectiveAPR",
+					Use:       "effective-apr",
+					Short:     "Shows the annual yield rate actually paid to the trust deposits",
+				},
+				{
+					RpcMethod: "Invariants",
+					Use:       "invariants",
+					Short:     "Runs the td module invariants and shows which are broken",
+				},
+				{
+					RpcMethod: "FundingGap",
+					Use:       "funding-gap",
+					Short:     "Compares the yearly yield the trust deposits require with what the verana_pool continuous fund streams",
+				},
+				{
+					RpcMethod: "YieldShortfall",
+					Use:       "yield-shortfall",
+					Short:     "Shows the yield verana_pool could not pay and still owes to the trust deposits",
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -28,15 +93,42 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "UpdateParamsPartial",
+					Skip:      true, // skipped because authority gated
+				},
 				{
 					RpcMethod: "FundModule",
 					Use:       "fund-module [amount] [module-name]",
-					Short:     "Send a fund-module tx",
+					Short:     "Send coins to a fundable module account; coins sent to td are a trust deposit",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "amount"},
 						{ProtoField: "module"},
 					},
 				},
+				{
+					RpcMethod:      "ReclaimTrustDeposit",
+					Use:            "reclaim-trust-deposit [amount]",
+					Short:          "Withdraw principal from your trust deposit after the unbonding period",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod: "ReclaimYield",
+					Use:       "reclaim-yield",
+					Short:     "Withdraw the yield earned by your trust deposit",
+				},
+				{
+					RpcMethod: "SlashTrustDeposit",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "UpdateContinuousFund",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "ResumeYield",
+					Skip:      true, // skipped because authority gated
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
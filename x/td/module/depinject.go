@@ -1,6 +1,9 @@
 package td
 
 import (
+	"maps"
+	"slices"
+
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
@@ -8,6 +11,11 @@ import (
 	"cosmossdk.io/depinject/appconfig"
 	"github.com/cosmos/cosmos-sdk/codec"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	epochskeeper "github.com/cosmos/cosmos-sdk/x/epochs/keeper"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	protocolpoolkeeper "github.com/cosmos/cosmos-sdk/x/protocolpool/keeper"
 
 	"veranatest/x/td/keeper"
 	"veranatest/x/td/types"
@@ -22,6 +30,7 @@ func init() {
 	appconfig.Register(
 		&types.Module{},
 		appconfig.Provide(ProvideModule),
+		appconfig.Invoke(InvokeSetSlashHooks),
 	)
 }
 
@@ -33,15 +42,20 @@ type ModuleInputs struct {
 	Cdc          codec.Codec
 	AddressCodec address.Codec
 
-	AuthKeeper types.AuthKeeper
-	BankKeeper types.BankKeeper
+	AuthKeeper         types.AuthKeeper
+	BankKeeper         types.BankKeeper
+	MintKeeper         mintkeeper.Keeper
+	EpochsKeeper       epochskeeper.Keeper
+	DistrKeeper        distrkeeper.Keeper
+	ProtocolPoolKeeper protocolpoolkeeper.Keeper
 }
 
 type ModuleOutputs struct {
 	depinject.Out
 
-	TdKeeper keeper.Keeper
-	Module   appmodule.AppModule
+	TdKeeper   keeper.Keeper
+	Module     appmodule.AppModule
+	EpochHooks epochstypes.EpochHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -57,8 +71,29 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		authority,
 		in.BankKeeper,
 		in.AuthKeeper,
+		mintkeeper.NewQueryServerImpl(in.MintKeeper),
+		&in.EpochsKeeper,
+		in.DistrKeeper,
+		in.ProtocolPoolKeeper,
+		protocolpoolkeeper.NewMsgServerImpl(in.ProtocolPoolKeeper),
 	)
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
-	return ModuleOutputs{TdKeeper: k, Module: m}
+	return ModuleOutputs{TdKeeper: k, Module: m, EpochHooks: epochstypes.EpochHooksWrapper{EpochHooks: k.EpochHooks()}}
+}
+
+// InvokeSetSlashHooks sets the slash hooks provided by other modules, ordered
+// by module name.
+func InvokeSetSlashHooks(keeper keeper.Keeper, slashHooks map[string]types.SlashHookWrapper) error {
+	if len(slashHooks) == 0 {
+		return nil
+	}
+
+	var hooks []types.SlashHook
+	for _, modName := range slices.Sorted(maps.Keys(slashHooks)) {
+		hooks = append(hooks, slashHooks[modName])
+	}
+	keeper.SetSlashHooks(hooks...)
+
+	return nil
 }
@@ -2,25 +2,122 @@ package td
 
 import (
 	"math/rand"
+	"time"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	tdsimulation "veranatest/x/td/simulation"
 	"veranatest/x/td/types"
 )
 
-// GenerateGenesisState creates a randomized GenState of the module.
+const (
+	trustDepositYieldRate = "td_trust_deposit_yield_rate"
+	feeShareRate          = "td_fee_share_rate"
+	maxAccrualDuration    = "td_max_accrual_duration"
+	yieldEpochIdentifier  = "td_yield_epoch_identifier"
+	retentionDuration     = "td_retention_duration"
+	sweepEpochIdentifier  = "td_sweep_epoch_identifier"
+	yieldFailureLimit     = "td_yield_failure_limit"
+)
+
+// GenerateGenesisState creates a randomized GenState of the module. Trust
+// deposits and the verana pool are funded in the bank genesis, which is
+// generated first as modules generate their genesis in name order, so that the
+// BeginBlocker has yield to move from the first block.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
-	accs := make([]string, len(simState.Accounts))
-	for i, acc := range simState.Accounts {
-		accs[i] = acc.Address.String()
+	params := types.DefaultParams()
+	params.Denom = simState.BondDenom
+	simState.AppParams.GetOrGenerate(trustDepositYieldRate, &params.TrustDepositYieldRate, simState.Rand, func(r *rand.Rand) {
+		params.TrustDepositYieldRate = simtypes.RandomDecAmount(r, params.MaxYieldRate)
+	})
+	simState.AppParams.GetOrGenerate(feeShareRate, &params.FeeShareRate, simState.Rand, func(r *rand.Rand) {
+		params.FeeShareRate = simtypes.RandomDecAmount(r, math.LegacyOneDec())
+	})
+	simState.AppParams.GetOrGenerate(maxAccrualDuration, &params.MaxAccrualDuration, simState.Rand, func(r *rand.Rand) {
+		params.MaxAccrualDuration = time.Duration(r.Intn(3600)) * time.Second
+	})
+	simState.AppParams.GetOrGenerate(yieldEpochIdentifier, &params.YieldEpochIdentifier, simState.Rand, func(r *rand.Rand) {
+		params.YieldEpochIdentifier = randomEpochIdentifier(r, simState)
+	})
+	simState.AppParams.GetOrGenerate(retentionDuration, &params.RetentionDuration, simState.Rand, func(r *rand.Rand) {
+		params.RetentionDuration = time.Duration(r.Intn(3600)) * time.Second
+	})
+	simState.AppParams.GetOrGenerate(sweepEpochIdentifier, &params.SweepEpochIdentifier, simState.Rand, func(r *rand.Rand) {
+		params.SweepEpochIdentifier = randomEpochIdentifier(r, simState)
+	})
+	simState.AppParams.GetOrGenerate(yieldFailureLimit, &params.YieldFailureLimit, simState.Rand, func(r *rand.Rand) {
+		params.YieldFailureLimit = uint32(r.Intn(5))
+	})
+
+	tdGenesis := types.DefaultGenesis()
+	tdGenesis.Params = params
+
+	if bz, ok := simState.GenState[banktypes.ModuleName]; ok {
+		var bankGenesis banktypes.GenesisState
+		simState.Cdc.MustUnmarshalJSON(bz, &bankGenesis)
+
+		trustDepositValue := math.ZeroInt()
+		for _, acc := range simState.Accounts {
+			if simState.Rand.Intn(2) == 0 {
+				continue
+			}
+			amount := uint64(simState.Rand.Int63n(1_000_000) + 1)
+			share := math.LegacyNewDecFromInt(math.NewIntFromUint64(amount)).Quo(tdGenesis.TrustDepositShareValue)
+			tdGenesis.TrustDeposits = append(tdGenesis.TrustDeposits, types.TrustDeposit{
+				Account: acc.Address.String(),
+				Amount:  amount,
+				Share:   share,
+			})
+			tdGenesis.TotalShares = tdGenesis.TotalShares.Add(share)
+			trustDepositValue = trustDepositValue.Add(math.NewIntFromUint64(amount))
+		}
+		tdGenesis.TrustDepositValue = trustDepositValue.Uint64()
+
+		veranaPool := math.NewInt(simState.Rand.Int63n(1_000_000_000))
+		for _, balance := range []struct {
+			module string
+			amount math.Int
+		}{
+			{module: types.ModuleName, amount: trustDepositValue},
+			{module: types.VeranaPoolAccount, amount: veranaPool},
+		} {
+			if !balance.amount.IsPositive() {
+				continue
+			}
+			coins := sdk.NewCoins(sdk.NewCoin(params.Denom, balance.amount))
+			bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{
+				Address: authtypes.NewModuleAddress(balance.module).String(),
+				Coins:   coins,
+			})
+			bankGenesis.Supply = bankGenesis.Supply.Add(coins...)
+		}
+		simState.GenState[banktypes.ModuleName] = simState.Cdc.MustMarshalJSON(&bankGenesis)
 	}
-	tdGenesis := types.GenesisState{
-		Params: types.DefaultParams(),
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(tdGenesis)
+}
+
+// randomEpochIdentifier returns one of the epochs of the epochs genesis, or an
+// empty identifier to move yield or sweep verana_pool every block.
+func randomEpochIdentifier(r *rand.Rand, simState *module.SimulationState) string {
+	bz, ok := simState.GenState[epochstypes.ModuleName]
+	if !ok || r.Intn(2) == 0 {
+		return ""
 	}
-	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&tdGenesis)
+	var epochsGenesis epochstypes.GenesisState
+	simState.Cdc.MustUnmarshalJSON(bz, &epochsGenesis)
+	if len(epochsGenesis.Epochs) == 0 {
+		return ""
+	}
+
+	return epochsGenesis.Epochs[r.Intn(len(epochsGenesis.Epochs))].Identifier
 }
 
 // RegisterStoreDecoder registers a decoder.
@@ -7,11 +7,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"veranatest/x/td/keeper"
 	"veranatest/x/td/types"
 )
 
+// SimulateMsgFundModule sends a random amount from a random account to a
+// random fundable module. Funding the td module is a trust deposit, so it is
+// made in the params denom only.
 func SimulateMsgFundModule(
 	ak types.AuthKeeper,
 	bk types.BankKeeper,
@@ -25,8 +29,43 @@ func SimulateMsgFundModule(
 			Creator: simAccount.Address.String(),
 		}
 
-		// TODO: Handle the FundModule simulation
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to get params"), nil, err
+		}
+		if len(params.FundableModules) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no fundable modules"), nil, nil
+		}
+		msg.Module = params.FundableModules[r.Intn(len(params.FundableModules))]
+
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		if msg.Module == types.ModuleName {
+			amount, err := simtypes.RandPositiveInt(r, spendable.AmountOf(params.Denom))
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no spendable coins in the params denom"), nil, nil
+			}
+			msg.Amount = sdk.NewCoins(sdk.NewCoin(params.Denom, amount))
+		} else {
+			msg.Amount = simtypes.RandSubsetCoins(r, spendable)
+		}
+		if msg.Amount.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "empty amount"), nil, nil
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: msg.Amount,
+		}
 
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "FundModule simulation not implemented"), nil, nil
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
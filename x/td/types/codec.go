@@ -11,8 +11,32 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 		&MsgFundModule{},
 	)
 
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgReclaimTrustDeposit{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgReclaimYield{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgResumeYield{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSlashTrustDeposit{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateContinuousFund{},
+	)
+
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateParamsPartial{},
+	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
@@ -8,5 +8,16 @@ import (
 
 // x/td module sentinel errors
 var (
-	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidSigner            = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidShareValue        = errors.Register(ModuleName, 1101, "invalid trust deposit share value")
+	ErrTrustDepositNotFound     = errors.Register(ModuleName, 1102, "trust deposit not found")
+	ErrInsufficientTrustDeposit = errors.Register(ModuleName, 1103, "insufficient trust deposit")
+	ErrNoYield                  = errors.Register(ModuleName, 1104, "no yield to reclaim")
+	ErrInvalidAmount            = errors.Register(ModuleName, 1105, "invalid amount")
+	ErrInvalidFraction          = errors.Register(ModuleName, 1106, "invalid slash fraction")
+	ErrModuleNotFundable        = errors.Register(ModuleName, 1107, "module cannot be funded")
+	ErrInvalidUpdateMask        = errors.Register(ModuleName, 1108, "invalid params update mask")
+	ErrYieldNotPaused           = errors.Register(ModuleName, 1109, "yield is not paused")
+	ErrUnknownEpoch             = errors.Register(ModuleName, 1110, "unknown epoch identifier")
+	ErrDenomLocked              = errors.Register(ModuleName, 1111, "denom cannot change while trust deposits are held")
 )
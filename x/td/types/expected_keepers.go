@@ -4,13 +4,18 @@ import (
 	"context"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	protocolpooltypes "github.com/cosmos/cosmos-sdk/x/protocolpool/types"
 )
 
 // AuthKeeper defines the expected interface for the Auth module.
 type AuthKeeper interface {
 	AddressCodec() address.Codec
 	GetAccount(context.Context, sdk.AccAddress) sdk.AccountI // only used for simulation
+	GetModuleAddress(moduleName string) sdk.AccAddress
 	// Methods imported from account should be defined here
 }
 
@@ -20,6 +25,8 @@ type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 }
 
 // ParamSubspace defines the expected Subspace interface for parameters.
@@ -30,4 +37,39 @@ type ParamSubspace interface {
 
 type AccountKeeper interface {
 	GetAccount(context.Context, sdk.AccAddress) sdk.AccountI
+	GetModuleAddress(moduleName string) sdk.AccAddress
+}
+
+// MintKeeper defines the expected interface for reading the mint params and
+// annual provisions. It is satisfied by the mint module's query server.
+type MintKeeper interface {
+	Params(context.Context, *minttypes.QueryParamsRequest) (*minttypes.QueryParamsResponse, error)
+	AnnualProvisions(context.Context, *minttypes.QueryAnnualProvisionsRequest) (*minttypes.QueryAnnualProvisionsResponse, error)
+}
+
+// DistributionKeeper defines the expected interface for reading the community
+// tax.
+type DistributionKeeper interface {
+	GetCommunityTax(ctx context.Context) (math.LegacyDec, error)
+}
+
+// ProtocolPoolKeeper defines the expected interface for reading the community
+// pool and its continuous funds.
+type ProtocolPoolKeeper interface {
+	GetAuthority() string
+	GetCommunityPool(ctx sdk.Context) (sdk.Coins, error)
+	GetAllContinuousFunds(ctx sdk.Context) ([]protocolpooltypes.ContinuousFund, error)
+}
+
+// ProtocolPoolMsgServer defines the expected interface for managing continuous
+// funds. It is satisfied by the protocolpool module's msg server, which td
+// calls with the protocolpool authority.
+type ProtocolPoolMsgServer interface {
+	CreateContinuousFund(context.Context, *protocolpooltypes.MsgCreateContinuousFund) (*protocolpooltypes.MsgCreateContinuousFundResponse, error)
+	CancelContinuousFund(context.Context, *protocolpooltypes.MsgCancelContinuousFund) (*protocolpooltypes.MsgCancelContinuousFundResponse, error)
+}
+
+// EpochsKeeper defines the expected interface for reading the x/epochs epochs.
+type EpochsKeeper interface {
+	GetEpochInfo(ctx sdk.Context, identifier string) (epochstypes.EpochInfo, error)
 }
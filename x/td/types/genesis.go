@@ -1,14 +1,126 @@
 package types
 
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultTrustDepositShareValue returns the value of one share before any
+// yield or fee-sourced funding is added, 1uvna.
+func DefaultTrustDepositShareValue() math.LegacyDec {
+	return math.LegacyOneDec()
+}
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:                 DefaultParams(),
+		DustAmount:             math.LegacyZeroDec(),
+		TotalShares:            math.LegacyZeroDec(),
+		TrustDepositShareValue: DefaultTrustDepositShareValue(),
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	// deposits are divided by the share value to issue shares. An unset share
+	// value is the default.
+	if !gs.TrustDepositShareValue.IsNil() && !gs.TrustDepositShareValue.IsPositive() {
+		return fmt.Errorf("trust deposit share value must be positive: %s", gs.TrustDepositShareValue)
+	}
+	if !gs.DustAmount.IsNil() && (gs.DustAmount.IsNegative() || gs.DustAmount.GTE(math.LegacyOneDec())) {
+		return fmt.Errorf("dust amount must be in [0, 1): %s", gs.DustAmount)
+	}
+	if gs.YieldDistribution != nil {
+		if gs.YieldDistribution.ValueTime.IsNil() || gs.YieldDistribution.ValueTime.IsNegative() {
+			return fmt.Errorf("yield distribution value time cannot be nil or negative: %s", gs.YieldDistribution.ValueTime)
+		}
+	}
+	if gs.YieldShortfall != nil {
+		if gs.YieldShortfall.TotalRepaid > gs.YieldShortfall.TotalRecorded ||
+			gs.YieldShortfall.Amount != gs.YieldShortfall.TotalRecorded-gs.YieldShortfall.TotalRepaid {
+			return fmt.Errorf("yield shortfall amount %d is not the recorded %d minus the repaid %d",
+				gs.YieldShortfall.Amount, gs.YieldShortfall.TotalRecorded, gs.YieldShortfall.TotalRepaid)
+		}
+	}
+	if err := gs.FeeSourcedFunding.Validate(); err != nil {
+		return fmt.Errorf("invalid fee sourced funding: %w", err)
+	}
+
+	if err := validateTrustDeposits(gs.TrustDeposits, gs.TotalShares); err != nil {
+		return err
+	}
+
+	withdrawalIDs := make(map[uint64]bool, len(gs.PendingWithdrawals))
+	for _, w := range gs.PendingWithdrawals {
+		if _, err := sdk.AccAddressFromBech32(w.Account); err != nil {
+			return fmt.Errorf("invalid pending withdrawal %d account %s: %w", w.Id, w.Account, err)
+		}
+		if withdrawalIDs[w.Id] {
+			return fmt.Errorf("duplicate pending withdrawal id %d", w.Id)
+		}
+		if w.Id >= gs.WithdrawalSeq {
+			return fmt.Errorf("pending withdrawal id %d is not below the withdrawal sequence %d", w.Id, gs.WithdrawalSeq)
+		}
+		withdrawalIDs[w.Id] = true
+	}
+
+	slashIDs := make(map[uint64]bool, len(gs.SlashRecords))
+	for _, r := range gs.SlashRecords {
+		if _, err := sdk.AccAddressFromBech32(r.Account); err != nil {
+			return fmt.Errorf("invalid slash record %d account %s: %w", r.Id, r.Account, err)
+		}
+		if slashIDs[r.Id] {
+			return fmt.Errorf("duplicate slash record id %d", r.Id)
+		}
+		if r.Id >= gs.SlashSeq {
+			return fmt.Errorf("slash record id %d is not below the slash sequence %d", r.Id, gs.SlashSeq)
+		}
+		if r.Fraction.IsNil() || !r.Fraction.IsPositive() || r.Fraction.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("slash record %d fraction must be in (0, 1]: %s", r.Id, r.Fraction)
+		}
+		slashIDs[r.Id] = true
+	}
+
+	return nil
+}
+
+// validateTrustDeposits checks that each account has at most one trust deposit
+// with a positive share and a lock within its amount, and that the shares add
+// up to totalShares. An unset totalShares is zero.
+func validateTrustDeposits(deposits []TrustDeposit, totalShares math.LegacyDec) error {
+	sum := math.LegacyZeroDec()
+	accounts := make(map[string]bool, len(deposits))
+	for _, d := range deposits {
+		if _, err := sdk.AccAddressFromBech32(d.Account); err != nil {
+			return fmt.Errorf("invalid trust deposit account %s: %w", d.Account, err)
+		}
+		if accounts[d.Account] {
+			return fmt.Errorf("duplicate trust deposit for account %s", d.Account)
+		}
+		if d.Share.IsNil() || !d.Share.IsPositive() {
+			return fmt.Errorf("trust deposit share of %s must be positive: %s", d.Account, d.Share)
+		}
+		if d.Locked > d.Amount {
+			return fmt.Errorf("trust deposit of %s locks %d, above its amount %d", d.Account, d.Locked, d.Amount)
+		}
+		accounts[d.Account] = true
+		sum = sum.Add(d.Share)
+	}
+
+	if totalShares.IsNil() {
+		totalShares = math.LegacyZeroDec()
+	}
+	if !sum.Equal(totalShares) {
+		return fmt.Errorf("trust deposit shares add up to %s, not the total shares %s", sum, totalShares)
+	}
+
+	return nil
 }
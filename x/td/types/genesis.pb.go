@@ -4,7 +4,10 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -28,6 +31,32 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// trust_deposit_value is the value of all trust deposits, in uvna.
+	TrustDepositValue uint64 `protobuf:"varint,2,opt,name=trust_deposit_value,json=trustDepositValue,proto3" json:"trust_deposit_value,omitempty"`
+	// dust_amount is the yield accrued but not moved yet, below 1uvna.
+	DustAmount cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=dust_amount,json=dustAmount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"dust_amount"`
+	// yield_accrual is unset if yield was never accrued.
+	YieldAccrual *YieldAccrual `protobuf:"bytes,4,opt,name=yield_accrual,json=yieldAccrual,proto3" json:"yield_accrual,omitempty"`
+	// yield_distribution is unset if yield was never accrued.
+	YieldDistribution *YieldDistribution `protobuf:"bytes,5,opt,name=yield_distribution,json=yieldDistribution,proto3" json:"yield_distribution,omitempty"`
+	// fee_sourced_funding is the total amount of fees moved to the module.
+	FeeSourcedFunding github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=fee_sourced_funding,json=feeSourcedFunding,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_sourced_funding"`
+	// total_shares is the sum of the shares of all trust deposits.
+	TotalShares        cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=total_shares,json=totalShares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"total_shares"`
+	TrustDeposits      []TrustDeposit              `protobuf:"bytes,8,rep,name=trust_deposits,json=trustDeposits,proto3" json:"trust_deposits"`
+	PendingWithdrawals []PendingWithdrawal         `protobuf:"bytes,9,rep,name=pending_withdrawals,json=pendingWithdrawals,proto3" json:"pending_withdrawals"`
+	// withdrawal_seq is the id of the next pending withdrawal.
+	WithdrawalSeq uint64        `protobuf:"varint,10,opt,name=withdrawal_seq,json=withdrawalSeq,proto3" json:"withdrawal_seq,omitempty"`
+	SlashRecords  []SlashRecord `protobuf:"bytes,11,rep,name=slash_records,json=slashRecords,proto3" json:"slash_records"`
+	// slash_seq is the id of the next slash record.
+	SlashSeq uint64 `protobuf:"varint,12,opt,name=slash_seq,json=slashSeq,proto3" json:"slash_seq,omitempty"`
+	// yield_shortfall is unset if verana_pool never failed to pay the yield.
+	YieldShortfall *YieldShortfall `protobuf:"bytes,13,opt,name=yield_shortfall,json=yieldShortfall,proto3" json:"yield_shortfall,omitempty"`
+	// yield_circuit is unset if yield was never moved or swept.
+	YieldCircuit *YieldCircuit `protobuf:"bytes,14,opt,name=yield_circuit,json=yieldCircuit,proto3" json:"yield_circuit,omitempty"`
+	// trust_deposit_share_value is the value of one share, in uvna. It is
+	// raised by yield and fee-sourced funding.
+	TrustDepositShareValue cosmossdk_io_math.LegacyDec `protobuf:"bytes,15,opt,name=trust_deposit_share_value,json=trustDepositShareValue,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"trust_deposit_share_value"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,6 +99,83 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetTrustDepositValue() uint64 {
+	if m != nil {
+		return m.TrustDepositValue
+	}
+	return 0
+}
+
+func (m *GenesisState) GetYieldAccrual() *YieldAccrual {
+	if m != nil {
+		return m.YieldAccrual
+	}
+	return nil
+}
+
+func (m *GenesisState) GetYieldDistribution() *YieldDistribution {
+	if m != nil {
+		return m.YieldDistribution
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeSourcedFunding() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeSourcedFunding
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTrustDeposits() []TrustDeposit {
+	if m != nil {
+		return m.TrustDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingWithdrawals() []PendingWithdrawal {
+	if m != nil {
+		return m.PendingWithdrawals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWithdrawalSeq() uint64 {
+	if m != nil {
+		return m.WithdrawalSeq
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSlashRecords() []SlashRecord {
+	if m != nil {
+		return m.SlashRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSlashSeq() uint64 {
+	if m != nil {
+		return m.SlashSeq
+	}
+	return 0
+}
+
+func (m *GenesisState) GetYieldShortfall() *YieldShortfall {
+	if m != nil {
+		return m.YieldShortfall
+	}
+	return nil
+}
+
+func (m *GenesisState) GetYieldCircuit() *YieldCircuit {
+	if m != nil {
+		return m.YieldCircuit
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "veranatest.td.v1.GenesisState")
 }
@@ -77,20 +183,50 @@ func init() {
 func init() { proto.RegisterFile("veranatest/td/v1/genesis.proto", fileDescriptor_beeb4f4b10f67ecd) }
 
 var fileDescriptor_beeb4f4b10f67ecd = []byte{
-	// 193 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2b, 0x4b, 0x2d, 0x4a,
-	0xcc, 0x4b, 0x2c, 0x49, 0x2d, 0x2e, 0xd1, 0x2f, 0x49, 0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd,
-	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x40, 0xc8, 0xeb, 0x95,
-	0xa4, 0xe8, 0x95, 0x19, 0x4a, 0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x22,
-	0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x30, 0x53, 0x1f, 0xc4, 0x82, 0x8a, 0xca, 0x62, 0x18, 0x5d,
-	0x90, 0x58, 0x94, 0x98, 0x0b, 0x35, 0x59, 0xc9, 0x9b, 0x8b, 0xc7, 0x1d, 0x62, 0x55, 0x70, 0x49,
-	0x62, 0x49, 0xaa, 0x90, 0x35, 0x17, 0x1b, 0x44, 0x5e, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48,
-	0x42, 0x0f, 0xdd, 0x6a, 0xbd, 0x00, 0xb0, 0xbc, 0x13, 0xe7, 0x89, 0x7b, 0xf2, 0x0c, 0x2b, 0x9e,
-	0x6f, 0xd0, 0x62, 0x0c, 0x82, 0x6a, 0x71, 0xd2, 0x3f, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39,
-	0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63,
-	0x39, 0x86, 0x28, 0x51, 0x24, 0x57, 0x54, 0x80, 0xdc, 0x51, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4,
-	0x06, 0x76, 0x84, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xd2, 0xef, 0x8c, 0xbe, 0x00, 0x01, 0x00,
-	0x00,
+	// 678 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xee, 0xfe, 0xca, 0x0f, 0xe9, 0xf4, 0x0f, 0x76, 0x50, 0x33, 0x80, 0x2c, 0x8d, 0xc4, 0xa4,
+	0x31, 0x71, 0x37, 0xc5, 0x78, 0xf2, 0x44, 0x69, 0x50, 0xa3, 0x07, 0xb2, 0x35, 0x1a, 0x39, 0xb8,
+	0x99, 0xee, 0x4e, 0xdb, 0x09, 0xdb, 0x9d, 0xb2, 0xef, 0x6c, 0xb1, 0x37, 0x3f, 0x82, 0x1f, 0xc3,
+	0x78, 0xf2, 0x63, 0x70, 0xf0, 0xc0, 0xd1, 0x78, 0x40, 0x03, 0x07, 0xbf, 0x86, 0x99, 0x99, 0xd5,
+	0x16, 0x0a, 0x86, 0x0b, 0xec, 0xbc, 0xcf, 0xf3, 0xbe, 0xcf, 0xdb, 0xf7, 0x7d, 0x66, 0x90, 0x3d,
+	0x62, 0x09, 0x8d, 0xa9, 0x64, 0x20, 0x5d, 0x19, 0xba, 0xa3, 0x86, 0xdb, 0x63, 0x31, 0x03, 0x0e,
+	0xce, 0x30, 0x11, 0x52, 0xe0, 0x9b, 0x13, 0xdc, 0x91, 0xa1, 0x33, 0x6a, 0xac, 0x54, 0xe9, 0x80,
+	0xc7, 0xc2, 0xd5, 0x7f, 0x0d, 0x69, 0xc5, 0x0e, 0x04, 0x0c, 0x04, 0xb8, 0x1d, 0x0a, 0xcc, 0x1d,
+	0x35, 0x3a, 0x4c, 0xd2, 0x86, 0x1b, 0x08, 0x1e, 0x67, 0xf8, 0xad, 0x9e, 0xe8, 0x09, 0xfd, 0xe9,
+	0xaa, 0xaf, 0x2c, 0xba, 0x36, 0x23, 0x3d, 0xa4, 0x09, 0x1d, 0x64, 0xca, 0x2b, 0x77, 0x67, 0x60,
+	0x39, 0x1e, 0xb2, 0x0c, 0xbd, 0xf7, 0x75, 0x01, 0x95, 0x9e, 0x9a, 0x4e, 0xdb, 0x92, 0x4a, 0x86,
+	0x9f, 0xa0, 0x79, 0x93, 0x4e, 0xac, 0x9a, 0x55, 0x2f, 0x6e, 0x12, 0xe7, 0x62, 0xe7, 0xce, 0xae,
+	0xc6, 0x9b, 0x85, 0xa3, 0x93, 0xf5, 0xdc, 0xa7, 0x5f, 0x5f, 0x1e, 0x58, 0x5e, 0x96, 0x82, 0x1d,
+	0xb4, 0x24, 0x93, 0x14, 0xa4, 0x1f, 0xb2, 0xa1, 0x00, 0x2e, 0xfd, 0x11, 0x8d, 0x52, 0x46, 0xfe,
+	0xab, 0x59, 0xf5, 0x39, 0xaf, 0xaa, 0xa1, 0x96, 0x41, 0x5e, 0x2b, 0x00, 0xb7, 0x50, 0x31, 0x54,
+	0x74, 0x3a, 0x10, 0x69, 0x2c, 0x49, 0xbe, 0x66, 0xd5, 0x0b, 0xcd, 0x0d, 0x55, 0xf7, 0xfb, 0xc9,
+	0xfa, 0xaa, 0x99, 0x06, 0x84, 0xfb, 0x0e, 0x17, 0xee, 0x80, 0xca, 0xbe, 0xf3, 0x92, 0xf5, 0x68,
+	0x30, 0x6e, 0xb1, 0xc0, 0x43, 0x2a, 0x6f, 0x4b, 0xa7, 0xe1, 0x6d, 0x54, 0x1e, 0x73, 0x16, 0x85,
+	0x3e, 0x0d, 0x82, 0x24, 0xa5, 0x11, 0x99, 0xd3, 0x9d, 0xdb, 0xb3, 0x9d, 0xbf, 0x55, 0xb4, 0x2d,
+	0xc3, 0xf2, 0x4a, 0xe3, 0xa9, 0x13, 0xf6, 0x10, 0x36, 0x45, 0x42, 0x0e, 0x32, 0xe1, 0x9d, 0x54,
+	0x72, 0x11, 0x93, 0xff, 0x75, 0xa5, 0x8d, 0x2b, 0x2a, 0xb5, 0xa6, 0xa8, 0x5e, 0x75, 0x7c, 0x31,
+	0x84, 0x3f, 0x58, 0x68, 0xa9, 0xcb, 0x98, 0x0f, 0x22, 0x4d, 0x02, 0x16, 0xfa, 0xdd, 0x34, 0x0e,
+	0x79, 0xdc, 0x23, 0xf3, 0xb5, 0x7c, 0xbd, 0xb8, 0xb9, 0xec, 0x98, 0x1f, 0xe8, 0xa8, 0x75, 0x3b,
+	0xd9, 0xba, 0x9d, 0x6d, 0xc1, 0xe3, 0xe6, 0x63, 0x35, 0x82, 0xcf, 0x3f, 0xd6, 0xeb, 0x3d, 0x2e,
+	0xfb, 0x69, 0xc7, 0x09, 0xc4, 0xc0, 0xcd, 0xbc, 0x61, 0xfe, 0x3d, 0x84, 0x70, 0x3f, 0xdb, 0xa3,
+	0x4a, 0x00, 0xb3, 0x86, 0x6a, 0x97, 0xb1, 0xb6, 0xd1, 0xda, 0x31, 0x52, 0x78, 0x07, 0x95, 0xa4,
+	0x90, 0x34, 0xf2, 0xa1, 0x4f, 0x13, 0x06, 0xe4, 0xc6, 0xf5, 0x47, 0x5c, 0xd4, 0x89, 0x6d, 0x9d,
+	0x87, 0x5f, 0xa0, 0xca, 0xb9, 0xcd, 0x02, 0x59, 0xa8, 0xe5, 0x2f, 0x1f, 0xf2, 0xab, 0xa9, 0x35,
+	0x37, 0xe7, 0x94, 0x92, 0x57, 0x9e, 0x5e, 0x3d, 0xe0, 0x3d, 0xb4, 0x34, 0x64, 0xba, 0x3f, 0xff,
+	0x90, 0xcb, 0x7e, 0x98, 0xd0, 0x43, 0x1a, 0x01, 0x29, 0xd4, 0xf2, 0x97, 0x0f, 0x7b, 0xd7, 0x90,
+	0xdf, 0xfc, 0xe5, 0x66, 0x65, 0xf1, 0xf0, 0x22, 0x00, 0xf8, 0x3e, 0xaa, 0x4c, 0x6a, 0xfa, 0xc0,
+	0x0e, 0x08, 0xd2, 0xee, 0x2b, 0x4f, 0xa2, 0x6d, 0x76, 0x80, 0x9f, 0xa1, 0x32, 0x44, 0x14, 0xfa,
+	0x7e, 0xc2, 0x02, 0x91, 0x84, 0x40, 0x8a, 0x5a, 0x7c, 0x6d, 0x56, 0xbc, 0xad, 0x68, 0x9e, 0x66,
+	0x65, 0xb2, 0x25, 0x98, 0x84, 0x00, 0xaf, 0xa2, 0x82, 0xa9, 0xa4, 0xb4, 0x4a, 0x5a, 0x6b, 0x41,
+	0x07, 0x94, 0xcc, 0x73, 0xb4, 0x68, 0x5c, 0x05, 0x7d, 0x91, 0xc8, 0x2e, 0x8d, 0x22, 0x52, 0xd6,
+	0x96, 0xaa, 0x5d, 0x61, 0xa9, 0xf6, 0x1f, 0x9e, 0x57, 0x19, 0x9f, 0x3b, 0x4f, 0x5c, 0x1e, 0xf0,
+	0x24, 0x48, 0xb9, 0x24, 0x95, 0x7f, 0xba, 0x7c, 0xdb, 0xb0, 0x32, 0x97, 0x67, 0x27, 0xfc, 0x0e,
+	0x2d, 0x9f, 0xbf, 0xa0, 0xda, 0x16, 0xd9, 0x35, 0x5d, 0xbc, 0xbe, 0x37, 0xee, 0x4c, 0x2f, 0x54,
+	0x5b, 0x44, 0x5f, 0xe8, 0xa6, 0x7b, 0x74, 0x6a, 0x5b, 0xc7, 0xa7, 0xb6, 0xf5, 0xf3, 0xd4, 0xb6,
+	0x3e, 0x9e, 0xd9, 0xb9, 0xe3, 0x33, 0x3b, 0xf7, 0xed, 0xcc, 0xce, 0xed, 0xdd, 0x9e, 0x7a, 0x86,
+	0xde, 0xab, 0x87, 0x48, 0xbb, 0xb7, 0x33, 0xaf, 0x9f, 0xa1, 0x47, 0xbf, 0x07, 0x00, 0x0b, 0xfe,
+	0x85, 0x1f, 0x40, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -113,6 +249,155 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.TrustDepositShareValue.Size()
+		i -= size
+		if _, err := m.TrustDepositShareValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if m.YieldCircuit != nil {
+		{
+			size, err := m.YieldCircuit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.YieldShortfall != nil {
+		{
+			size, err := m.YieldShortfall.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.SlashSeq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SlashSeq))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.SlashRecords) > 0 {
+		for iNdEx := len(m.SlashRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.WithdrawalSeq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.WithdrawalSeq))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.PendingWithdrawals) > 0 {
+		for iNdEx := len(m.PendingWithdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingWithdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.TrustDeposits) > 0 {
+		for iNdEx := len(m.TrustDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TrustDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size := m.TotalShares.Size()
+		i -= size
+		if _, err := m.TotalShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.FeeSourcedFunding) > 0 {
+		for iNdEx := len(m.FeeSourcedFunding) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSourcedFunding[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.YieldDistribution != nil {
+		{
+			size, err := m.YieldDistribution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.YieldAccrual != nil {
+		{
+			size, err := m.YieldAccrual.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.DustAmount.Size()
+		i -= size
+		if _, err := m.DustAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TrustDepositValue != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TrustDepositValue))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -145,6 +430,61 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.TrustDepositValue != 0 {
+		n += 1 + sovGenesis(uint64(m.TrustDepositValue))
+	}
+	l = m.DustAmount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.YieldAccrual != nil {
+		l = m.YieldAccrual.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.YieldDistribution != nil {
+		l = m.YieldDistribution.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.FeeSourcedFunding) > 0 {
+		for _, e := range m.FeeSourcedFunding {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.TotalShares.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TrustDeposits) > 0 {
+		for _, e := range m.TrustDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingWithdrawals) > 0 {
+		for _, e := range m.PendingWithdrawals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.WithdrawalSeq != 0 {
+		n += 1 + sovGenesis(uint64(m.WithdrawalSeq))
+	}
+	if len(m.SlashRecords) > 0 {
+		for _, e := range m.SlashRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SlashSeq != 0 {
+		n += 1 + sovGenesis(uint64(m.SlashSeq))
+	}
+	if m.YieldShortfall != nil {
+		l = m.YieldShortfall.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.YieldCircuit != nil {
+		l = m.YieldCircuit.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.TrustDepositShareValue.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -216,6 +556,445 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustDepositValue", wireType)
+			}
+			m.TrustDepositValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrustDepositValue |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DustAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldAccrual", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.YieldAccrual == nil {
+				m.YieldAccrual = &YieldAccrual{}
+			}
+			if err := m.YieldAccrual.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.YieldDistribution == nil {
+				m.YieldDistribution = &YieldDistribution{}
+			}
+			if err := m.YieldDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSourcedFunding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSourcedFunding = append(m.FeeSourcedFunding, types.Coin{})
+			if err := m.FeeSourcedFunding[len(m.FeeSourcedFunding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustDeposits = append(m.TrustDeposits, TrustDeposit{})
+			if err := m.TrustDeposits[len(m.TrustDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingWithdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingWithdrawals = append(m.PendingWithdrawals, PendingWithdrawal{})
+			if err := m.PendingWithdrawals[len(m.PendingWithdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalSeq", wireType)
+			}
+			m.WithdrawalSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRecords = append(m.SlashRecords, SlashRecord{})
+			if err := m.SlashRecords[len(m.SlashRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashSeq", wireType)
+			}
+			m.SlashSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldShortfall", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.YieldShortfall == nil {
+				m.YieldShortfall = &YieldShortfall{}
+			}
+			if err := m.YieldShortfall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldCircuit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.YieldCircuit == nil {
+				m.YieldCircuit = &YieldCircuit{}
+			}
+			if err := m.YieldCircuit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustDepositShareValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrustDepositShareValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
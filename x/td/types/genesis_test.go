@@ -2,13 +2,37 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"veranatest/testutil/sample"
 	"veranatest/x/td/types"
 
 	"github.com/stretchr/testify/require"
 )
 
+// genesisWithParams returns the default genesis with its params modified by f.
+func genesisWithParams(f func(*types.Params)) *types.GenesisState {
+	genState := types.DefaultGenesis()
+	f(&genState.Params)
+	return genState
+}
+
+// genesisWithState returns the default genesis with one trust deposit of
+// account, modified by f.
+func genesisWithState(account string, f func(*types.GenesisState)) *types.GenesisState {
+	genState := types.DefaultGenesis()
+	genState.TrustDeposits = []types.TrustDeposit{{Account: account, Amount: 1000, Share: math.LegacyNewDec(1000)}}
+	genState.TotalShares = math.LegacyNewDec(1000)
+	f(genState)
+	return genState
+}
+
 func TestGenesisState_Validate(t *testing.T) {
+	account := sample.AccAddress()
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -20,10 +44,231 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
+			desc: "valid genesis state",
+			genState: &types.GenesisState{Params: types.Params{
+				TrustDepositYieldRate: math.LegacyZeroDec(),
+				MaxYieldRate:          math.LegacyZeroDec(),
+				Denom:                 "uvna",
+			}},
+			valid: true,
+		},
+		{
+			desc:     "empty params",
 			genState: &types.GenesisState{},
+			valid:    false,
+		},
+		{
+			desc:     "missing denom",
+			genState: genesisWithParams(func(p *types.Params) { p.Denom = "" }),
+			valid:    false,
+		},
+		{
+			desc:     "empty fundable module",
+			genState: genesisWithParams(func(p *types.Params) { p.FundableModules = []string{""} }),
+			valid:    false,
+		},
+		{
+			desc:     "duplicate fundable module",
+			genState: genesisWithParams(func(p *types.Params) { p.FundableModules = []string{"td", "td"} }),
+			valid:    false,
+		},
+		{
+			desc:     "fee share rate above one",
+			genState: genesisWithParams(func(p *types.Params) { p.FeeShareRate = math.LegacyNewDecWithPrec(11, 1) }),
+			valid:    false,
+		},
+		{
+			desc:     "negative fee share rate",
+			genState: genesisWithParams(func(p *types.Params) { p.FeeShareRate = math.LegacyNewDec(-1) }),
+			valid:    false,
+		},
+		{
+			desc:     "zero share value",
+			genState: genesisWithState(account, func(gs *types.GenesisState) { gs.TrustDepositShareValue = math.LegacyZeroDec() }),
+			valid:    false,
+		},
+		{
+			desc:     "negative yield rate",
+			genState: genesisWithParams(func(p *types.Params) { p.TrustDepositYieldRate = math.LegacyNewDecWithPrec(-1, 2) }),
+			valid:    false,
+		},
+		{
+			desc:     "yield rate at the max",
+			genState: genesisWithParams(func(p *types.Params) { p.TrustDepositYieldRate = p.MaxYieldRate }),
+			valid:    true,
+		},
+		{
+			desc:     "yield rate above the max",
+			genState: genesisWithParams(func(p *types.Params) { p.TrustDepositYieldRate = math.LegacyNewDec(100) }),
+			valid:    false,
+		},
+		{
+			desc:     "max yield rate above one",
+			genState: genesisWithParams(func(p *types.Params) { p.MaxYieldRate = math.LegacyNewDecWithPrec(11, 1) }),
+			valid:    false,
+		},
+		{
+			desc:     "retention buffer",
+			genState: genesisWithParams(func(p *types.Params) { p.RetentionDuration = time.Hour }),
+			valid:    true,
+		},
+		{
+			desc:     "negative retention duration",
+			genState: genesisWithParams(func(p *types.Params) { p.RetentionDuration = -time.Second }),
+			valid:    false,
+		},
+		{
+			desc:     "blank sweep epoch identifier",
+			genState: genesisWithParams(func(p *types.Params) { p.SweepEpochIdentifier = " " }),
+			valid:    false,
+		},
+		{
+			desc:     "trust deposit state",
+			genState: genesisWithState(account, func(*types.GenesisState) {}),
 			valid:    true,
 		},
+		{
+			desc: "locked trust deposit",
+			genState: genesisWithState(account, func(gs *types.GenesisState) {
+				gs.TrustDeposits[0].Locked = 1000
+			}),
+			valid: true,
+		},
+		{
+			desc: "lock above the trust deposit amount",
+			genState: genesisWithState(account, func(gs *types.GenesisState) {
+				gs.TrustDeposits[0].Locked = 1001
+			}),
+			valid: false,
+		},
+		{
+			desc:     "dust below one",
+			genState: genesisWithState(account, func(gs *types.GenesisState) { gs.DustAmount = math.LegacyNewDecWithPrec(99, 2) }),
+			valid:    true,
+		},
+		{
+			desc:     "dust of one",
+			genState: genesisWithState(account, func(gs *types.GenesisState) { gs.DustAmount = math.LegacyOneDec() }),
+			valid:    false,
+		},
+		{
+			desc:     "negative dust",
+			genState: genesisWithState(account, func(gs *types.GenesisState) { gs.DustAmount = math.LegacyNewDec(-1) }),
+			valid:    false,
+		},
+		{
+			desc: "nil yield distribution value time",
+			genState: genesisWithState(account, func(gs *types.GenesisState) {
+				gs.YieldDistribution = &types.YieldDistribution{}
+			}),
+			valid: false,
+		},
+		{
+			desc: "yield shortfall",
+			genState: genesisWithState(account, func(gs *types.GenesisState) {
+				gs.YieldShortfall = &types.YieldShortfall{Amount: 5, TotalRecorded: 15, TotalRepaid: 10}
+			}),
+			valid: true,
+		},
+		{
+			desc: "yield shortfall not matching its totals",
+			genState: genesisWithState(account, func(gs *types.GenesisState) {
+				gs.YieldShortfall = &types.YieldShortfall{Amount: 5, TotalRecorded: 15}
+			}),
+			valid: false,
+		},
+		{
+			desc: "yield shortfall repaid above recorded",
+			genState: genesisWithState(account, func(gs *types.GenesisState) {
+				gs.YieldShortfall = &types.YieldShortfall{TotalRecorded: 10, TotalRepaid: 15}
+			}),
+			valid: false,
+		},
+		{
+			desc: "invalid fee sourced funding",
+			genState: genesisWithState(account, func(gs *types.GenesisState) {
+				gs.FeeSourcedFunding = sdk.Coins{{Denom: "uvna", Amount: math.NewInt(-1)}}
+			}),
+			valid: false,
+		},
+		{
+			desc:     "total shares mismatch",
+			genState: genesisWithState(account, func(gs *types.GenesisState) { gs.TotalShares = math.LegacyNewDec(999) }),
+			valid:    false,
+		},
+		{
+			desc: "duplicate trust deposit",
+			genState: genesisWithState(account, func(gs *types.GenesisState) {
+				gs.TrustDeposits = append(gs.TrustDeposits, gs.TrustDeposits[0])
+				gs.TotalShares = math.LegacyNewDec(2000)
+			}),
+			valid: false,
+		},
+		{
+			desc: "invalid trust deposit account",
+			genState: genesisWithState(account, func(gs *types.GenesisState) {
+				gs.TrustDeposits[0].Account = "invalid"
+			}),
+			valid: false,
+		},
+		{
+			desc: "zero trust deposit share",
+			genState: genesisWithState(account, func(gs *types.GenesisState) {
+				gs.TrustDeposits[0].Share = math.LegacyZeroDec()
+				gs.TotalShares = math.LegacyZeroDec()
+			}),
+			valid: false,
+		},
+		{
+			desc: "pending withdrawal",
+			genState: genesisWithState(account, func(gs *types.GenesisState) {
+				gs.PendingWithdrawals = []types.PendingWithdrawal{{Id: 0, Account: account, Amount: 100}}
+				gs.WithdrawalSeq = 1
+			}),
+			valid: true,
+		},
+		{
+			desc: "pending withdrawal id not below the sequence",
+			genState: genesisWithState(account, func(gs *types.GenesisState) {
+				gs.PendingWithdrawals = []types.PendingWithdrawal{{Id: 1, Account: account, Amount: 100}}
+				gs.WithdrawalSeq = 1
+			}),
+			valid: false,
+		},
+		{
+			desc: "duplicate pending withdrawal id",
+			genState: genesisWithState(account, func(gs *types.GenesisState) {
+				gs.PendingWithdrawals = []types.PendingWithdrawal{
+					{Id: 0, Account: account, Amount: 100},
+					{Id: 0, Account: sample.AccAddress(), Amount: 100},
+				}
+				gs.WithdrawalSeq = 2
+			}),
+			valid: false,
+		},
+		{
+			desc: "slash record",
+			genState: genesisWithState(account, func(gs *types.GenesisState) {
+				gs.SlashRecords = []types.SlashRecord{{Id: 0, Account: account, Fraction: math.LegacyNewDecWithPrec(1, 1)}}
+				gs.SlashSeq = 1
+			}),
+			valid: true,
+		},
+		{
+			desc: "slash record id not below the sequence",
+			genState: genesisWithState(account, func(gs *types.GenesisState) {
+				gs.SlashRecords = []types.SlashRecord{{Id: 0, Account: account, Fraction: math.LegacyNewDecWithPrec(1, 1)}}
+			}),
+			valid: false,
+		},
+		{
+			desc: "slash record fraction above one",
+			genState: genesisWithState(account, func(gs *types.GenesisState) {
+				gs.SlashRecords = []types.SlashRecord{{Id: 0, Account: account, Fraction: math.LegacyNewDec(2)}}
+				gs.SlashSeq = 1
+			}),
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
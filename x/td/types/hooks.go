@@ -0,0 +1,43 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SlashHook is implemented by modules that react to trust deposit slashes.
+type SlashHook interface {
+	// AfterTrustDepositSlashed is called after amount uvna has been slashed from
+	// the trust deposit of account.
+	AfterTrustDepositSlashed(ctx context.Context, account sdk.AccAddress, amount math.Int) error
+}
+
+// MultiSlashHooks combines multiple slash hooks, called in order.
+type MultiSlashHooks []SlashHook
+
+var _ SlashHook = MultiSlashHooks{}
+
+// NewMultiSlashHooks creates a new MultiSlashHooks.
+func NewMultiSlashHooks(hooks ...SlashHook) MultiSlashHooks {
+	return hooks
+}
+
+// AfterTrustDepositSlashed implements SlashHook.
+func (h MultiSlashHooks) AfterTrustDepositSlashed(ctx context.Context, account sdk.AccAddress, amount math.Int) error {
+	for _, hook := range h {
+		if err := hook.AfterTrustDepositSlashed(ctx, account, amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SlashHookWrapper is a wrapper for modules to inject a SlashHook using
+// depinject.
+type SlashHookWrapper struct{ SlashHook }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (SlashHookWrapper) IsOnePerModuleType() {}
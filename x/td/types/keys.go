@@ -14,10 +14,29 @@ const (
 	// See: https://github.com/cosmos/cosmos-sdk/blob/v0.52.0-beta.2/x/gov/types/keys.go#L9
 	GovModuleName     = "gov"
 	VeranaPoolAccount = "verana_pool"
+
+	// VeranaPoolFundingAccount receives the verana_pool continuous fund, as the
+	// protocolpool cannot stream to the blocked verana_pool address. The td
+	// BeginBlocker moves its balance to verana_pool.
+	VeranaPoolFundingAccount = "verana_pool_funding"
 )
 
 // ParamsKey is the prefix to retrieve all Params
 var (
-	ParamsKey     = collections.NewPrefix("p_td")
-	DustAmountKey = collections.NewPrefix(1)
+	ParamsKey                            = collections.NewPrefix("p_td")
+	DustAmountKey                        = collections.NewPrefix(1)
+	FeeSourcedFundingKey                 = collections.NewPrefix(2)
+	YieldAccrualKey                      = collections.NewPrefix(3)
+	TrustDepositKey                      = collections.NewPrefix(4)
+	TotalSharesKey                       = collections.NewPrefix(5)
+	PendingWithdrawalKey                 = collections.NewPrefix(6)
+	PendingWithdrawalByCompletionTimeKey = collections.NewPrefix(7)
+	WithdrawalSeqKey                     = collections.NewPrefix(8)
+	SlashRecordKey                       = collections.NewPrefix(9)
+	SlashSeqKey                          = collections.NewPrefix(10)
+	TrustDepositValueKey                 = collections.NewPrefix(11)
+	YieldDistributionKey                 = collections.NewPrefix(12)
+	YieldShortfallKey                    = collections.NewPrefix(13)
+	YieldCircuitKey                      = collections.NewPrefix(14)
+	TrustDepositShareValueKey            = collections.NewPrefix(15)
 )
@@ -0,0 +1,58 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"veranatest/testutil/sample"
+	"veranatest/x/td/types"
+)
+
+func TestMsgFundModule_ValidateBasic(t *testing.T) {
+	creator := sample.AccAddress()
+
+	tests := []struct {
+		name   string
+		msg    types.MsgFundModule
+		expErr error
+	}{
+		{
+			name: "valid",
+			msg:  types.MsgFundModule{Creator: creator, Amount: sdk.NewCoins(sdk.NewInt64Coin("uvna", 1)), Module: types.ModuleName},
+		},
+		{
+			name:   "invalid creator",
+			msg:    types.MsgFundModule{Creator: "invalid", Amount: sdk.NewCoins(sdk.NewInt64Coin("uvna", 1)), Module: types.ModuleName},
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name:   "no amount",
+			msg:    types.MsgFundModule{Creator: creator, Module: types.ModuleName},
+			expErr: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name:   "negative amount",
+			msg:    types.MsgFundModule{Creator: creator, Amount: sdk.Coins{{Denom: "uvna", Amount: math.NewInt(-1)}}, Module: types.ModuleName},
+			expErr: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name:   "no module",
+			msg:    types.MsgFundModule{Creator: creator, Amount: sdk.NewCoins(sdk.NewInt64Coin("uvna", 1))},
+			expErr: types.ErrModuleNotFundable,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,49 +1,230 @@
 package types
 
-import "cosmossdk.io/math"
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
-const DefaultTrustDepositValue uint64 = 0
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Year is the length of a year used for yield accrual, 365.25 days.
+const Year = 8766 * time.Hour
+
+// DefaultMaxAccrualDuration is the default cap on the block time yield is
+// accrued for in a single block.
+const DefaultMaxAccrualDuration = time.Minute
+
+// DefaultUnbondingPeriod is the default time a reclaimed trust deposit is held
+// before it is paid out.
+const DefaultUnbondingPeriod = 21 * 24 * time.Hour
+
+// DefaultYieldFailureLimit is the default number of consecutive failed yield
+// transfers or sweeps after which they are paused.
+const DefaultYieldFailureLimit = 3
 
 const (
-	DefaultTrustDepositShareValue = "1.0"
-	DefaultTrustDepositYieldRate  = "0.15"
+	DefaultTrustDepositYieldRate = "0.15"
+	DefaultFeeShareRate          = "0.0"
+	DefaultDenom                 = "uvna"
+	DefaultMaxYieldRate          = "0.5"
 )
 
+// DefaultFundableModules returns the module accounts MsgFundModule can send
+// coins to by default.
+func DefaultFundableModules() []string {
+	return []string{ModuleName, VeranaPoolAccount}
+}
+
 // NewParams creates a new Params instance.
-func NewParams(trustDepositShareValue math.LegacyDec, trust_deposit_value uint64, trust_deposit_yield_rate math.LegacyDec) Params {
-	return Params{TrustDepositShareValue: trustDepositShareValue, TrustDepositValue: trust_deposit_value, TrustDepositYieldRate: trust_deposit_yield_rate}
+func NewParams(trust_deposit_yield_rate math.LegacyDec, feeShareRate math.LegacyDec, maxAccrualDuration time.Duration, unbondingPeriod time.Duration, denom string, fundableModules []string, maxYieldRate math.LegacyDec) Params {
+	return Params{TrustDepositYieldRate: trust_deposit_yield_rate, FeeShareRate: feeShareRate, MaxAccrualDuration: maxAccrualDuration, UnbondingPeriod: unbondingPeriod, Denom: denom, FundableModules: fundableModules, MaxYieldRate: maxYieldRate}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	TrustDepositShareValue, _ := math.LegacyNewDecFromStr(DefaultTrustDepositShareValue)
 	TrustDepositYieldRate, _ := math.LegacyNewDecFromStr(DefaultTrustDepositYieldRate)
-	return NewParams(TrustDepositShareValue, DefaultTrustDepositValue, TrustDepositYieldRate)
+	FeeShareRate, _ := math.LegacyNewDecFromStr(DefaultFeeShareRate)
+	MaxYieldRate, _ := math.LegacyNewDecFromStr(DefaultMaxYieldRate)
+	params := NewParams(TrustDepositYieldRate, FeeShareRate, DefaultMaxAccrualDuration, DefaultUnbondingPeriod, DefaultDenom, DefaultFundableModules(), MaxYieldRate)
+	params.YieldFailureLimit = DefaultYieldFailureLimit
+	return params
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
-	if err := validateTrustDepositShareValue(p.TrustDepositShareValue); err != nil {
+	if err := validateMaxYieldRate(p.MaxYieldRate); err != nil {
+		return err
+	}
+	if err := validateTrustDepositYieldRate(p.TrustDepositYieldRate, p.MaxYieldRate); err != nil {
+		return err
+	}
+	if err := validateFeeShareRate(p.FeeShareRate); err != nil {
+		return err
+	}
+	if err := validateMaxAccrualDuration(p.MaxAccrualDuration); err != nil {
+		return err
+	}
+	if err := validateUnbondingPeriod(p.UnbondingPeriod); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+	if err := validateFundableModules(p.FundableModules); err != nil {
+		return err
+	}
+	if err := validateEpochIdentifier("yield", p.YieldEpochIdentifier); err != nil {
 		return err
 	}
-	if err := validateTrustDepositValue(p.TrustDepositValue); err != nil {
+	if err := validateRetentionDuration(p.RetentionDuration); err != nil {
 		return err
 	}
-	if err := validateTrustDepositYieldRate(p.TrustDepositYieldRate); err != nil {
+	if err := validateEpochIdentifier("sweep", p.SweepEpochIdentifier); err != nil {
 		return err
 	}
 
 	return nil
 }
-func validateTrustDepositShareValue(v math.LegacyDec) error {
+
+// validateMaxYieldRate checks that the yield rate cap is between 0 and 1.
+func validateMaxYieldRate(v math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("max yield rate cannot be nil")
+	}
+	if v.IsNegative() || v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max yield rate must be between 0 and 1: %s", v)
+	}
 
 	return nil
 }
-func validateTrustDepositValue(v uint64) error {
+
+// validateTrustDepositYieldRate checks that the yield rate is between 0 and
+// max.
+func validateTrustDepositYieldRate(v, maxRate math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("trust deposit yield rate cannot be nil")
+	}
+	if v.IsNegative() || v.GT(maxRate) {
+		return fmt.Errorf("trust deposit yield rate must be between 0 and the max yield rate %s: %s", maxRate, v)
+	}
 
 	return nil
 }
-func validateTrustDepositYieldRate(v math.LegacyDec) error {
+
+// validateFeeShareRate checks that the fee share rate is between 0 and 1. An
+// unset rate shares no fees.
+func validateFeeShareRate(v math.LegacyDec) error {
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() || v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("fee share rate must be between 0 and 1: %s", v)
+	}
 
 	return nil
 }
+
+// validateMaxAccrualDuration checks that the accrual cap is not negative. A zero
+// cap accrues no yield.
+func validateMaxAccrualDuration(v time.Duration) error {
+	if v < 0 {
+		return fmt.Errorf("max accrual duration cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+// validateUnbondingPeriod checks that the unbonding period is not negative. With
+// a zero period, reclaimed trust deposits are paid out at the end of the block.
+func validateUnbondingPeriod(v time.Duration) error {
+	if v < 0 {
+		return fmt.Errorf("unbonding period cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+// validateFundableModules checks that the fundable modules are non-empty and
+// unique.
+func validateFundableModules(v []string) error {
+	seen := make(map[string]bool, len(v))
+	for _, module := range v {
+		if module == "" {
+			return fmt.Errorf("fundable module cannot be empty")
+		}
+		if seen[module] {
+			return fmt.Errorf("duplicate fundable module: %s", module)
+		}
+		seen[module] = true
+	}
+
+	return nil
+}
+
+// validateEpochIdentifier checks that the epoch identifier has no surrounding
+// whitespace. An empty identifier is allowed and means no epoch.
+func validateEpochIdentifier(name, v string) error {
+	if strings.TrimSpace(v) != v {
+		return fmt.Errorf("%s epoch identifier cannot have surrounding whitespace: %q", name, v)
+	}
+
+	return nil
+}
+
+// validateRetentionDuration checks that the retention duration is not negative.
+// With a zero duration, all of verana_pool is returned to the community pool.
+func validateRetentionDuration(v time.Duration) error {
+	if v < 0 {
+		return fmt.Errorf("retention duration cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+// ApplyUpdateMask returns p with the fields listed in updateMask, by proto
+// field name, set from update.
+func (p Params) ApplyUpdateMask(update Params, updateMask []string) (Params, error) {
+	if len(updateMask) == 0 {
+		return Params{}, errorsmod.Wrap(ErrInvalidUpdateMask, "update mask cannot be empty")
+	}
+
+	for _, path := range updateMask {
+		switch path {
+		case "trust_deposit_yield_rate":
+			p.TrustDepositYieldRate = update.TrustDepositYieldRate
+		case "fee_share_rate":
+			p.FeeShareRate = update.FeeShareRate
+		case "max_accrual_duration":
+			p.MaxAccrualDuration = update.MaxAccrualDuration
+		case "unbonding_period":
+			p.UnbondingPeriod = update.UnbondingPeriod
+		case "denom":
+			p.Denom = update.Denom
+		case "fundable_modules":
+			p.FundableModules = update.FundableModules
+		case "max_yield_rate":
+			p.MaxYieldRate = update.MaxYieldRate
+		case "yield_epoch_identifier":
+			p.YieldEpochIdentifier = update.YieldEpochIdentifier
+		case "retention_duration":
+			p.RetentionDuration = update.RetentionDuration
+		case "sweep_epoch_identifier":
+			p.SweepEpochIdentifier = update.SweepEpochIdentifier
+		case "yield_failure_limit":
+			p.YieldFailureLimit = update.YieldFailureLimit
+		default:
+			return Params{}, errorsmod.Wrapf(ErrInvalidUpdateMask, "unknown param %q", path)
+		}
+	}
+
+	return p, nil
+}
+
+// IsFundable returns whether MsgFundModule can send coins to module.
+func (p Params) IsFundable(module string) bool {
+	return slices.Contains(p.FundableModules, module)
+}
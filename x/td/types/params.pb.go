@@ -9,15 +9,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,9 +31,38 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
-	TrustDepositShareValue cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=trust_deposit_share_value,json=trustDepositShareValue,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"trust_deposit_share_value" yaml:"trust_deposit_share_value"`
-	TrustDepositValue      uint64                      `protobuf:"varint,2,opt,name=trust_deposit_value,json=trustDepositValue,proto3" json:"trust_deposit_value,omitempty"`
-	TrustDepositYieldRate  cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=trust_deposit_yield_rate,json=trustDepositYieldRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"trust_deposit_yield_rate" yaml:"trust_deposit_yield_rate"`
+	TrustDepositYieldRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=trust_deposit_yield_rate,json=trustDepositYieldRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"trust_deposit_yield_rate" yaml:"trust_deposit_yield_rate"`
+	// fee_share_rate is the fraction of each transaction's fees moved from the
+	// fee collector to the trust deposit module.
+	FeeShareRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=fee_share_rate,json=feeShareRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_share_rate" yaml:"fee_share_rate"`
+	// max_accrual_duration caps the block time elapsed since the previous accrual
+	// that yield is accrued for in a single block.
+	MaxAccrualDuration time.Duration `protobuf:"bytes,5,opt,name=max_accrual_duration,json=maxAccrualDuration,proto3,stdduration" json:"max_accrual_duration" yaml:"max_accrual_duration"`
+	// unbonding_period is the time a reclaimed trust deposit is held before it
+	// is paid out.
+	UnbondingPeriod time.Duration `protobuf:"bytes,6,opt,name=unbonding_period,json=unbondingPeriod,proto3,stdduration" json:"unbonding_period" yaml:"unbonding_period"`
+	// denom is the denom of trust deposits and yield.
+	Denom string `protobuf:"bytes,7,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// fundable_modules are the module accounts MsgFundModule can send coins to.
+	FundableModules []string `protobuf:"bytes,8,rep,name=fundable_modules,json=fundableModules,proto3" json:"fundable_modules,omitempty" yaml:"fundable_modules"`
+	// max_yield_rate is the highest trust_deposit_yield_rate that can be set.
+	MaxYieldRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=max_yield_rate,json=maxYieldRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_yield_rate" yaml:"max_yield_rate"`
+	// yield_epoch_identifier is the x/epochs identifier, e.g. "hour", at the end
+	// of which yield is moved from verana_pool. If empty, yield is moved every
+	// block.
+	YieldEpochIdentifier string `protobuf:"bytes,10,opt,name=yield_epoch_identifier,json=yieldEpochIdentifier,proto3" json:"yield_epoch_identifier,omitempty" yaml:"yield_epoch_identifier"`
+	// retention_duration is the time of yield, at the current trust deposit
+	// value and yield rate, verana_pool keeps when leftover funds are returned
+	// to the community pool. Only the excess is returned.
+	RetentionDuration time.Duration `protobuf:"bytes,11,opt,name=retention_duration,json=retentionDuration,proto3,stdduration" json:"retention_duration" yaml:"retention_duration"`
+	// sweep_epoch_identifier is the x/epochs identifier at the end of which the
+	// excess of verana_pool is returned to the community pool. If empty, it is
+	// returned whenever yield is moved.
+	SweepEpochIdentifier string `protobuf:"bytes,12,opt,name=sweep_epoch_identifier,json=sweepEpochIdentifier,proto3" json:"sweep_epoch_identifier,omitempty" yaml:"sweep_epoch_identifier"`
+	// yield_failure_limit is the number of consecutive failed yield transfers or
+	// sweeps after which they are paused until the authority resumes them. If
+	// zero, they are never paused.
+	YieldFailureLimit uint32 `protobuf:"varint,13,opt,name=yield_failure_limit,json=yieldFailureLimit,proto3" json:"yield_failure_limit,omitempty" yaml:"yield_failure_limit"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -65,9 +98,58 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
-func (m *Params) GetTrustDepositValue() uint64 {
+func (m *Params) GetMaxAccrualDuration() time.Duration {
 	if m != nil {
-		return m.TrustDepositValue
+		return m.MaxAccrualDuration
+	}
+	return 0
+}
+
+func (m *Params) GetUnbondingPeriod() time.Duration {
+	if m != nil {
+		return m.UnbondingPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Params) GetFundableModules() []string {
+	if m != nil {
+		return m.FundableModules
+	}
+	return nil
+}
+
+func (m *Params) GetYieldEpochIdentifier() string {
+	if m != nil {
+		return m.YieldEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetRetentionDuration() time.Duration {
+	if m != nil {
+		return m.RetentionDuration
+	}
+	return 0
+}
+
+func (m *Params) GetSweepEpochIdentifier() string {
+	if m != nil {
+		return m.SweepEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetYieldFailureLimit() uint32 {
+	if m != nil {
+		return m.YieldFailureLimit
 	}
 	return 0
 }
@@ -79,27 +161,48 @@ func init() {
 func init() { proto.RegisterFile("veranatest/td/v1/params.proto", fileDescriptor_0f98fae846061fd5) }
 
 var fileDescriptor_0f98fae846061fd5 = []byte{
-	// 319 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2d, 0x4b, 0x2d, 0x4a,
-	0xcc, 0x4b, 0x2c, 0x49, 0x2d, 0x2e, 0xd1, 0x2f, 0x49, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c,
-	0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x40, 0x48, 0xeb, 0x95, 0xa4,
-	0xe8, 0x95, 0x19, 0x4a, 0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x22, 0x29,
-	0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x30, 0x53, 0x1f, 0xc4, 0x82, 0x88, 0x2a, 0xdd, 0x67, 0xe2, 0x62,
-	0x0b, 0x00, 0x9b, 0x25, 0xd4, 0xc4, 0xc8, 0x25, 0x59, 0x52, 0x54, 0x5a, 0x5c, 0x12, 0x9f, 0x92,
-	0x5a, 0x90, 0x5f, 0x9c, 0x59, 0x12, 0x5f, 0x9c, 0x91, 0x58, 0x94, 0x1a, 0x5f, 0x96, 0x98, 0x53,
-	0x9a, 0x2a, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0xe4, 0x7e, 0xe2, 0x9e, 0x3c, 0xc3, 0xad, 0x7b,
-	0xf2, 0xd2, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xc5, 0x29, 0xd9, 0x7a, 0x99, 0xf9, 0xfa, 0xb9,
-	0x89, 0x25, 0x19, 0x7a, 0x3e, 0xa9, 0xe9, 0x89, 0xc9, 0x95, 0x2e, 0xa9, 0xc9, 0x9f, 0xee, 0xc9,
-	0x2b, 0x54, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0xe1, 0x34, 0x4d, 0x29, 0x48, 0x0c, 0x2c, 0xe7, 0x02,
-	0x91, 0x0a, 0x06, 0xc9, 0x84, 0x81, 0x24, 0x84, 0xf4, 0xb8, 0x84, 0x51, 0x75, 0x41, 0x6c, 0x67,
-	0x52, 0x60, 0xd4, 0x60, 0x09, 0x12, 0x44, 0xd6, 0x04, 0x51, 0x5f, 0xcf, 0x25, 0x81, 0xaa, 0xbe,
-	0x32, 0x33, 0x35, 0x27, 0x25, 0xbe, 0x28, 0xb1, 0x24, 0x55, 0x82, 0x19, 0xec, 0x64, 0x37, 0xe2,
-	0x9c, 0x2c, 0x8f, 0xcd, 0xc9, 0x08, 0xc3, 0x94, 0x82, 0x44, 0x91, 0x2d, 0x8f, 0x04, 0x49, 0x04,
-	0x25, 0x96, 0xa4, 0x5a, 0xc9, 0xbf, 0x58, 0x20, 0xcf, 0xd8, 0xf5, 0x7c, 0x83, 0x96, 0x18, 0x52,
-	0x1c, 0x55, 0x80, 0x62, 0x09, 0x12, 0xac, 0x4e, 0xfa, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24,
-	0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78,
-	0x2c, 0xc7, 0x10, 0x25, 0x8a, 0xae, 0xa3, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x33,
-	0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfe, 0xc0, 0x5d, 0x84, 0xf5, 0x01, 0x00, 0x00,
+	// 646 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0xe3, 0xfe, 0x23, 0xbd, 0xa6, 0x34, 0x75, 0xd3, 0xe2, 0xb6, 0xaa, 0x1d, 0x8c, 0x80,
+	0x88, 0xc1, 0x56, 0x61, 0xab, 0x58, 0x88, 0x4a, 0x25, 0xaa, 0x82, 0x2a, 0x33, 0x54, 0xb0, 0x58,
+	0x97, 0xf8, 0x4d, 0x7a, 0xc2, 0xf6, 0x59, 0xe7, 0x73, 0x48, 0x26, 0x76, 0x26, 0x46, 0x46, 0x3e,
+	0x02, 0x1f, 0xa3, 0x63, 0x47, 0xc4, 0x60, 0x50, 0x3b, 0xc0, 0xec, 0x4f, 0x80, 0x7c, 0x97, 0x3f,
+	0x4d, 0x1a, 0x54, 0xc4, 0x12, 0xf9, 0x9e, 0xe7, 0xcd, 0xf3, 0xf3, 0xbd, 0xef, 0xf9, 0xd0, 0x4e,
+	0x07, 0x18, 0x0e, 0x31, 0x87, 0x98, 0xdb, 0xdc, 0xb3, 0x3b, 0xbb, 0x76, 0x84, 0x19, 0x0e, 0x62,
+	0x2b, 0x62, 0x94, 0x53, 0xb5, 0x3c, 0xb2, 0x2d, 0xee, 0x59, 0x9d, 0xdd, 0xad, 0x55, 0x1c, 0x90,
+	0x90, 0xda, 0xe2, 0x57, 0x16, 0x6d, 0x55, 0xda, 0xb4, 0x4d, 0xc5, 0xa3, 0x9d, 0x3f, 0xf5, 0x55,
+	0xbd, 0x4d, 0x69, 0xdb, 0x07, 0x5b, 0xac, 0x1a, 0x49, 0xcb, 0xf6, 0x12, 0x86, 0x39, 0xa1, 0xa1,
+	0xf4, 0xcd, 0xac, 0x88, 0x16, 0x8e, 0x05, 0x4b, 0xfd, 0x80, 0x34, 0xce, 0x92, 0x98, 0xbb, 0x1e,
+	0x44, 0x34, 0x26, 0xdc, 0xed, 0x11, 0xf0, 0x3d, 0x97, 0x61, 0x0e, 0xda, 0x6c, 0x55, 0xa9, 0x2d,
+	0xd6, 0x0f, 0xce, 0x52, 0xa3, 0xf0, 0x3d, 0x35, 0xb6, 0x9b, 0x34, 0x0e, 0x68, 0x1c, 0x7b, 0xef,
+	0x2c, 0x42, 0xed, 0x00, 0xf3, 0x53, 0xeb, 0x08, 0xda, 0xb8, 0xd9, 0xdb, 0x87, 0x66, 0x96, 0x1a,
+	0x46, 0x0f, 0x07, 0xfe, 0x9e, 0xf9, 0xb7, 0x30, 0xd3, 0x59, 0x17, 0xd6, 0xbe, 0x74, 0xde, 0xe4,
+	0x86, 0x83, 0x39, 0xa8, 0x0d, 0x74, 0xbb, 0x05, 0xe0, 0xc6, 0xa7, 0x98, 0x81, 0xc4, 0xce, 0x09,
+	0xec, 0xd3, 0x7f, 0xc3, 0xae, 0x4b, 0xec, 0x78, 0x84, 0xe9, 0x94, 0x5a, 0x00, 0xaf, 0xf3, 0xb5,
+	0x60, 0x70, 0x54, 0x09, 0x70, 0xd7, 0xc5, 0xcd, 0x26, 0x4b, 0xb0, 0xef, 0x0e, 0xba, 0xa1, 0xcd,
+	0x57, 0x95, 0xda, 0xd2, 0xe3, 0x4d, 0x4b, 0xb6, 0xcb, 0x1a, 0xb4, 0xcb, 0xda, 0xef, 0x17, 0xd4,
+	0x1f, 0xe6, 0x2f, 0x91, 0xa5, 0xc6, 0xb6, 0xa4, 0x4c, 0x0b, 0x31, 0x3f, 0xff, 0x30, 0x14, 0x47,
+	0x0d, 0x70, 0xf7, 0x99, 0x74, 0x06, 0x7f, 0x56, 0x09, 0x2a, 0x27, 0x61, 0x83, 0x86, 0x1e, 0x09,
+	0xdb, 0x6e, 0x04, 0x8c, 0x50, 0x4f, 0x5b, 0xb8, 0x89, 0x78, 0xaf, 0x4f, 0xbc, 0x23, 0x89, 0x93,
+	0x01, 0x92, 0xb6, 0x32, 0x94, 0x8f, 0x85, 0xaa, 0x3e, 0x40, 0xf3, 0x1e, 0x84, 0x34, 0xd0, 0x6e,
+	0x89, 0xde, 0x95, 0xb3, 0xd4, 0x28, 0xc9, 0x00, 0x21, 0x9b, 0x8e, 0xb4, 0xd5, 0x03, 0x54, 0x6e,
+	0x25, 0xa1, 0x87, 0x1b, 0x3e, 0xb8, 0x01, 0xf5, 0x12, 0x1f, 0x62, 0xad, 0x58, 0x9d, 0xad, 0x2d,
+	0xd6, 0xb7, 0x47, 0xcc, 0xc9, 0x0a, 0xd3, 0x59, 0x19, 0x48, 0x2f, 0xa5, 0x92, 0x0f, 0x2d, 0xef,
+	0xc5, 0x95, 0xb3, 0xb2, 0xf8, 0x1f, 0x43, 0x1b, 0x8f, 0x30, 0x9d, 0x52, 0x80, 0xbb, 0xa3, 0x83,
+	0x71, 0x82, 0x36, 0xa4, 0x09, 0x11, 0x6d, 0x9e, 0xba, 0xc4, 0x83, 0x90, 0x93, 0x16, 0x01, 0xa6,
+	0x21, 0xc1, 0xba, 0x9b, 0xa5, 0xc6, 0x8e, 0x0c, 0x9a, 0x5e, 0x67, 0x3a, 0x15, 0x61, 0x3c, 0xcf,
+	0xf5, 0x17, 0x43, 0x59, 0xa5, 0x48, 0x65, 0xc0, 0xf3, 0x25, 0x0d, 0x47, 0x67, 0x61, 0xe9, 0xa6,
+	0xc9, 0xdc, 0xef, 0x4f, 0x66, 0x53, 0x32, 0xaf, 0x47, 0xc8, 0xd9, 0xac, 0x0e, 0x8d, 0xe1, 0x41,
+	0x38, 0x41, 0x1b, 0xf1, 0x7b, 0x80, 0xe8, 0xfa, 0x4e, 0x4a, 0x93, 0x3b, 0x99, 0x5e, 0x67, 0x3a,
+	0x15, 0x61, 0x4c, 0xee, 0xe4, 0x15, 0x5a, 0x93, 0x5b, 0x6f, 0x61, 0xe2, 0x27, 0x0c, 0x5c, 0x9f,
+	0x04, 0x84, 0x6b, 0xcb, 0x55, 0xa5, 0xb6, 0x5c, 0xd7, 0xb3, 0xd4, 0xd8, 0xba, 0xda, 0x9f, 0xb1,
+	0x22, 0xd3, 0x59, 0x15, 0xea, 0x81, 0x14, 0x8f, 0x72, 0x6d, 0xcf, 0xf8, 0xfd, 0xc5, 0x50, 0x3e,
+	0xfe, 0xfa, 0xfa, 0x68, 0xe3, 0xca, 0xd5, 0xd4, 0xcd, 0x2f, 0x27, 0x79, 0x5b, 0x1c, 0xce, 0x15,
+	0x95, 0xf2, 0xcc, 0xe1, 0x5c, 0x71, 0xa6, 0x3c, 0xeb, 0x6c, 0x8e, 0x7f, 0xea, 0xf2, 0xeb, 0xeb,
+	0x60, 0x3f, 0x01, 0x67, 0x6d, 0xdc, 0x12, 0x62, 0xdd, 0x3e, 0xbb, 0xd0, 0x95, 0xf3, 0x0b, 0x5d,
+	0xf9, 0x79, 0xa1, 0x2b, 0x9f, 0x2e, 0xf5, 0xc2, 0xf9, 0xa5, 0x5e, 0xf8, 0x76, 0xa9, 0x17, 0xde,
+	0xae, 0x4f, 0xd2, 0x78, 0x2f, 0x82, 0xb8, 0xb1, 0x20, 0x66, 0xf0, 0xe4, 0xcf, 0x00, 0xc5, 0xbb,
+	0xf5, 0xd0, 0x28, 0x05, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -121,13 +224,42 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.TrustDepositShareValue.Equal(that1.TrustDepositShareValue) {
+	if !this.TrustDepositYieldRate.Equal(that1.TrustDepositYieldRate) {
 		return false
 	}
-	if this.TrustDepositValue != that1.TrustDepositValue {
+	if !this.FeeShareRate.Equal(that1.FeeShareRate) {
 		return false
 	}
-	if !this.TrustDepositYieldRate.Equal(that1.TrustDepositYieldRate) {
+	if this.MaxAccrualDuration != that1.MaxAccrualDuration {
+		return false
+	}
+	if this.UnbondingPeriod != that1.UnbondingPeriod {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if len(this.FundableModules) != len(that1.FundableModules) {
+		return false
+	}
+	for i := range this.FundableModules {
+		if this.FundableModules[i] != that1.FundableModules[i] {
+			return false
+		}
+	}
+	if !this.MaxYieldRate.Equal(that1.MaxYieldRate) {
+		return false
+	}
+	if this.YieldEpochIdentifier != that1.YieldEpochIdentifier {
+		return false
+	}
+	if this.RetentionDuration != that1.RetentionDuration {
+		return false
+	}
+	if this.SweepEpochIdentifier != that1.SweepEpochIdentifier {
+		return false
+	}
+	if this.YieldFailureLimit != that1.YieldFailureLimit {
 		return false
 	}
 	return true
@@ -152,31 +284,95 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.YieldFailureLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.YieldFailureLimit))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.SweepEpochIdentifier) > 0 {
+		i -= len(m.SweepEpochIdentifier)
+		copy(dAtA[i:], m.SweepEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.SweepEpochIdentifier)))
+		i--
+		dAtA[i] = 0x62
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RetentionDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RetentionDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x5a
+	if len(m.YieldEpochIdentifier) > 0 {
+		i -= len(m.YieldEpochIdentifier)
+		copy(dAtA[i:], m.YieldEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.YieldEpochIdentifier)))
+		i--
+		dAtA[i] = 0x52
+	}
 	{
-		size := m.TrustDepositYieldRate.Size()
+		size := m.MaxYieldRate.Size()
 		i -= size
-		if _, err := m.TrustDepositYieldRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxYieldRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if m.TrustDepositValue != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.TrustDepositValue))
+	dAtA[i] = 0x4a
+	if len(m.FundableModules) > 0 {
+		for iNdEx := len(m.FundableModules) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FundableModules[iNdEx])
+			copy(dAtA[i:], m.FundableModules[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.FundableModules[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x3a
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAccrualDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAccrualDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.FeeShareRate.Size()
+		i -= size
+		if _, err := m.FeeShareRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x22
 	{
-		size := m.TrustDepositShareValue.Size()
+		size := m.TrustDepositYieldRate.Size()
 		i -= size
-		if _, err := m.TrustDepositShareValue.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.TrustDepositYieldRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x1a
 	return len(dAtA) - i, nil
 }
 
@@ -197,13 +393,39 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
-	l = m.TrustDepositShareValue.Size()
+	l = m.TrustDepositYieldRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.FeeShareRate.Size()
 	n += 1 + l + sovParams(uint64(l))
-	if m.TrustDepositValue != 0 {
-		n += 1 + sovParams(uint64(m.TrustDepositValue))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAccrualDuration)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
 	}
-	l = m.TrustDepositYieldRate.Size()
+	if len(m.FundableModules) > 0 {
+		for _, s := range m.FundableModules {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.MaxYieldRate.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = len(m.YieldEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RetentionDuration)
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.SweepEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.YieldFailureLimit != 0 {
+		n += 1 + sovParams(uint64(m.YieldFailureLimit))
+	}
 	return n
 }
 
@@ -242,9 +464,9 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrustDepositShareValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustDepositYieldRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -272,15 +494,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TrustDepositShareValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TrustDepositYieldRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrustDepositValue", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeShareRate", wireType)
 			}
-			m.TrustDepositValue = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -290,14 +512,95 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TrustDepositValue |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeShareRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrustDepositYieldRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAccrualDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxAccrualDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -325,10 +628,190 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TrustDepositYieldRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundableModules", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundableModules = append(m.FundableModules, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxYieldRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxYieldRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.YieldEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RetentionDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SweepEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SweepEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldFailureLimit", wireType)
+			}
+			m.YieldFailureLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.YieldFailureLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -5,25 +5,34 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -114,213 +123,5691 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "veranatest.td.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "veranatest.td.v1.QueryParamsResponse")
+// QueryFeeSourcedFundingRequest is request type for the Query/FeeSourcedFunding RPC method.
+type QueryFeeSourcedFundingRequest struct {
 }
 
-func init() { proto.RegisterFile("veranatest/td/v1/query.proto", fileDescriptor_1a899e63edc17efc) }
+func (m *QueryFeeSourcedFundingRequest) Reset()         { *m = QueryFeeSourcedFundingRequest{} }
+func (m *QueryFeeSourcedFundingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSourcedFundingRequest) ProtoMessage()    {}
+func (*QueryFeeSourcedFundingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a899e63edc17efc, []int{2}
+}
+func (m *QueryFeeSourcedFundingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSourcedFundingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSourcedFundingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeSourcedFundingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSourcedFundingRequest.Merge(m, src)
+}
+func (m *QueryFeeSourcedFundingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSourcedFundingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSourcedFundingRequest.DiscardUnknown(m)
+}
 
-var fileDescriptor_1a899e63edc17efc = []byte{
-	// 304 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0x4b, 0x2d, 0x4a,
-	0xcc, 0x4b, 0x2c, 0x49, 0x2d, 0x2e, 0xd1, 0x2f, 0x49, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d,
-	0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x40, 0xc8, 0xea, 0x95, 0xa4, 0xe8,
-	0x95, 0x19, 0x4a, 0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x22, 0x29, 0xad,
-	0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0x62, 0xfd, 0xa4, 0xc4, 0xe2, 0x54, 0x88, 0x6e, 0xfd, 0x32, 0xc3,
-	0xa4, 0xd4, 0x92, 0x44, 0x43, 0xfd, 0x82, 0xc4, 0xf4, 0xcc, 0xbc, 0xc4, 0x92, 0xcc, 0xfc, 0x3c,
-	0xa8, 0x5a, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x30, 0x53, 0x1f, 0xc4, 0x82, 0x8a, 0xca, 0xa4, 0xe7,
-	0xe7, 0xa7, 0xe7, 0xa4, 0xea, 0x27, 0x16, 0x64, 0xea, 0x27, 0xe6, 0xe5, 0xe5, 0x97, 0x80, 0xb5,
-	0x14, 0x43, 0x65, 0x65, 0x31, 0x9c, 0x58, 0x90, 0x58, 0x94, 0x98, 0x0b, 0x95, 0x56, 0x12, 0xe1,
-	0x12, 0x0a, 0x04, 0x59, 0x1a, 0x00, 0x16, 0x0c, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x51, 0x0a,
-	0xe2, 0x12, 0x46, 0x11, 0x2d, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x15, 0xb2, 0xe6, 0x62, 0x83, 0x68,
-	0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd0, 0x43, 0xf7, 0xa1, 0x1e, 0x44, 0x87, 0x13,
-	0xe7, 0x89, 0x7b, 0xf2, 0x0c, 0x2b, 0x9e, 0x6f, 0xd0, 0x62, 0x0c, 0x82, 0x6a, 0x31, 0x6a, 0x60,
-	0xe4, 0x62, 0x05, 0x1b, 0x2a, 0x54, 0xce, 0xc5, 0x06, 0x51, 0x26, 0xa4, 0x82, 0x69, 0x00, 0xa6,
-	0x6b, 0xa4, 0x54, 0x09, 0xa8, 0x82, 0xb8, 0x4e, 0x49, 0xa1, 0xe9, 0xf2, 0x93, 0xc9, 0x4c, 0x52,
-	0x42, 0x12, 0xfa, 0x38, 0xbc, 0xec, 0xa4, 0x7f, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c,
-	0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72,
-	0x0c, 0x51, 0xa2, 0x48, 0x5a, 0x2a, 0x40, 0x9a, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0,
-	0x81, 0x64, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x4e, 0xd3, 0x3c, 0xe8, 0x01, 0x00, 0x00,
+var xxx_messageInfo_QueryFeeSourcedFundingRequest proto.InternalMessageInfo
+
+// QueryFeeSourcedFundingResponse is response type for the Query/FeeSourcedFunding RPC method.
+type QueryFeeSourcedFundingResponse struct {
+	// amount is the total amount of fees moved to the trust deposit module.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryFeeSourcedFundingResponse) Reset()         { *m = QueryFeeSourcedFundingResponse{} }
+func (m *QueryFeeSourcedFundingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSourcedFundingResponse) ProtoMessage()    {}
+func (*QueryFeeSourcedFundingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a899e63edc17efc, []int{3}
+}
+func (m *QueryFeeSourcedFundingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSourcedFundingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSourcedFundingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeSourcedFundingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSourcedFundingResponse.Merge(m, src)
+}
+func (m *QueryFeeSourcedFundingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSourcedFundingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSourcedFundingResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryFeeSourcedFundingResponse proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryFeeSourcedFundingResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryTrustDepositRequest is request type for the Query/TrustDeposit RPC method.
+type QueryTrustDepositRequest struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryTrustDepositRequest) Reset()         { *m = QueryTrustDepositRequest{} }
+func (m *QueryTrustDepositRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTrustDepositRequest) ProtoMessage()    {}
+func (*QueryTrustDepositRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a899e63edc17efc, []int{4}
+}
+func (m *QueryTrustDepositRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTrustDepositRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTrustDepositRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTrustDepositRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTrustDepositRequest.Merge(m, src)
+}
+func (m *QueryTrustDepositRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTrustDepositRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTrustDepositRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/veranatest.td.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTrustDepositRequest proto.InternalMessageInfo
+
+func (m *QueryTrustDepositRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+// QueryTrustDepositResponse is response type for the Query/TrustDeposit RPC method.
+type QueryTrustDepositResponse struct {
+	TrustDeposit TrustDeposit `protobuf:"bytes,1,opt,name=trust_deposit,json=trustDeposit,proto3" json:"trust_deposit"`
+	// value is the value of the deposit in uvna, share times share_value rounded
+	// down.
+	Value uint64 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	// share_value is the current trust deposit share value.
+	ShareValue cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=share_value,json=shareValue,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share_value"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryTrustDepositResponse) Reset()         { *m = QueryTrustDepositResponse{} }
+func (m *QueryTrustDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTrustDepositResponse) ProtoMessage()    {}
+func (*QueryTrustDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a899e63edc17efc, []int{5}
+}
+func (m *QueryTrustDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTrustDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTrustDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTrustDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTrustDepositResponse.Merge(m, src)
+}
+func (m *QueryTrustDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTrustDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTrustDepositResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+var xxx_messageInfo_QueryTrustDepositResponse proto.InternalMessageInfo
+
+func (m *QueryTrustDepositResponse) GetTrustDeposit() TrustDeposit {
+	if m != nil {
+		return m.TrustDeposit
+	}
+	return TrustDeposit{}
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryTrustDepositResponse) GetValue() uint64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// QueryPendingWithdrawalsRequest is request type for the Query/PendingWithdrawals RPC method.
+type QueryPendingWithdrawalsRequest struct {
+	Account    string             `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingWithdrawalsRequest) Reset()         { *m = QueryPendingWithdrawalsRequest{} }
+func (m *QueryPendingWithdrawalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingWithdrawalsRequest) ProtoMessage()    {}
+func (*QueryPendingWithdrawalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a899e63edc17efc, []int{6}
+}
+func (m *QueryPendingWithdrawalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingWithdrawalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingWithdrawalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+}
+func (m *QueryPendingWithdrawalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingWithdrawalsRequest.Merge(m, src)
+}
+func (m *QueryPendingWithdrawalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingWithdrawalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingWithdrawalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingWithdrawalsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingWithdrawalsRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/veranatest.td.v1.Query/Params",
+	return ""
+}
+
+func (m *QueryPendingWithdrawalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return nil
+}
+
+// QueryPendingWithdrawalsResponse is response type for the Query/PendingWithdrawals RPC method.
+type QueryPendingWithdrawalsResponse struct {
+	PendingWithdrawals []PendingWithdrawal `protobuf:"bytes,1,rep,name=pending_withdrawals,json=pendingWithdrawals,proto3" json:"pending_withdrawals"`
+	Pagination         *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingWithdrawalsResponse) Reset()         { *m = QueryPendingWithdrawalsResponse{} }
+func (m *QueryPendingWithdrawalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingWithdrawalsResponse) ProtoMessage()    {}
+func (*QueryPendingWithdrawalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a899e63edc17efc, []int{7}
+}
+func (m *QueryPendingWithdrawalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingWithdrawalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingWithdrawalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPendingWithdrawalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingWithdrawalsResponse.Merge(m, src)
+}
+func (m *QueryPendingWithdrawalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingWithdrawalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingWithdrawalsResponse.DiscardUnknown(m)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "veranatest.td.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "veranatest/td/v1/query.proto",
+var xxx_messageInfo_QueryPendingWithdrawalsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingWithdrawalsResponse) GetPendingWithdrawals() []PendingWithdrawal {
+	if m != nil {
+		return m.PendingWithdrawals
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingWithdrawalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySlashHistoryRequest is request type for the Query/SlashHistory RPC method.
+type QuerySlashHistoryRequest struct {
+	Account    string             `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QuerySlashHistoryRequest) Reset()         { *m = QuerySlashHistoryRequest{} }
+func (m *QuerySlashHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashHistoryRequest) ProtoMessage()    {}
+func (*QuerySlashHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a899e63edc17efc, []int{8}
+}
+func (m *QuerySlashHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashHistoryRequest.Merge(m, src)
+}
+func (m *QuerySlashHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySlashHistoryRequest proto.InternalMessageInfo
+
+func (m *QuerySlashHistoryRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySlashHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+// QuerySlashHistoryResponse is response type for the Query/SlashHistory RPC method.
+type QuerySlashHistoryResponse struct {
+	SlashRecords []SlashRecord       `protobuf:"bytes,1,rep,name=slash_records,json=slashRecords,proto3" json:"slash_records"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySlashHistoryResponse) Reset()         { *m = QuerySlashHistoryResponse{} }
+func (m *QuerySlashHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashHistoryResponse) ProtoMessage()    {}
+func (*QuerySlashHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a899e63edc17efc, []int{9}
+}
+func (m *QuerySlashHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashHistoryResponse.Merge(m, src)
+}
+func (m *QuerySlashHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashHistoryResponse proto.InternalMessageInfo
+
+func (m *QuerySlashHistoryResponse) GetSlashRecords() []SlashRecord {
+	if m != nil {
+		return m.SlashRecords
+	}
+	return nil
+}
+
+func (m *QuerySlashHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDustAmountRequest is request type for the Query/DustAmount RPC method.
+type QueryDustAmountRequest struct {
+}
+
+func (m *QueryDustAmountRequest) Reset()         { *m = QueryDustAmountRequest{} }
+func (m *QueryDustAmountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDustAmountRequest) ProtoMessage()    {}
+func (*QueryDustAmountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a899e63edc17efc, []int{10}
+}
+func (m *QueryDustAmountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDustAmountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDustAmountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDustAmountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDustAmountRequest.Merge(m, src)
+}
+func (m *QueryDustAmountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDustAmountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDustAmountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDustAmountRequest proto.InternalMessageInfo
+
+// QueryDustAmountResponse is response type for the Query/DustAmount RPC method.
+type QueryDustAmountResponse struct {
+	Dust cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=dust,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"dust"`
+}
+
+func (m *QueryDustAmountResponse) Reset()         { *m = QueryDustAmountResponse{} }
+func (m *QueryDustAmountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDustAmountResponse) ProtoMessage()    {}
+func (*QueryDustAmountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a899e63edc17efc, []int{11}
+}
+func (m *QueryDustAmountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDustAmountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDustAmountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDustAmountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDustAmountResponse.Merge(m, src)
+}
+func (m *QueryDustAmountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDustAmountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDustAmountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDustAmountResponse proto.InternalMessageInfo
+
+// QueryModuleBalancesRequest is request type for the Query/ModuleBalances RPC method.
+type QueryModuleBalancesRequest struct {
+}
+
+func (m *QueryModuleBalancesRequest) Reset()         { *m = QueryModuleBalancesRequest{} }
+func (m *QueryModuleBalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleBalancesRequest) ProtoMessage()    {}
+func (*QueryModuleBalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a899e63edc17efc, []int{12}
+}
+func (m *QueryModuleBalancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleBalancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleBalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleBalancesRequest.Merge(m, src)
+}
+func (m *QueryModuleBalancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleBalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleBalancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleBalancesRequest proto.InternalMessageInfo
+
+// QueryModuleBalancesResponse is response type for the Query/ModuleBalances RPC method.
+type QueryModuleBalancesResponse struct {
+	Td         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=td,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"td"`
+	VeranaPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=verana_pool,json=veranaPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"verana_pool"`
+}
+
+func (m *QueryModuleBalancesResponse) Reset()         { *m = QueryModuleBalancesResponse{} }
+func (m *QueryModuleBalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleBalancesResponse) ProtoMessage()    {}
+func (*QueryModuleBalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a899e63edc17efc, []int{13}
+}
+func (m *QueryModuleBalancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleBalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleBalancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleBalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleBalancesResponse.Merge(m, src)
+}
+func (m *QueryModuleBalancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleBalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleBalancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleBalancesResponse proto.InternalMessageInfo
+
+func (m *QueryModuleBalancesResponse) GetTd() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Td
+	}
+	return nil
+}
+
+func (m *QueryModuleBalancesResponse) GetVeranaPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.VeranaPool
+	}
+	return nil
+}
+
+// QueryYieldStateRequest is request type for the Query/YieldState RPC method.
+type QueryYieldStateRequest struct {
+}
+
+func (m *QueryYieldStateRequest) Reset()         { *m = QueryYieldStateRequest{} }
+func (m *QueryYieldStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryYieldStateRequest) ProtoMessage()    {}
+func (*QueryYieldStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a899e63edc17efc, []int{14}
+}
+func (m *QueryYieldStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryYieldStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryYieldStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryYieldStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryYieldStateRequest.Merge(m, src)
+}
+func (m *QueryYieldStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryYieldStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryYieldStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryYieldStateRequest proto.InternalMessageInfo
+
+// QueryYieldStateResponse is response type for the Query/YieldState RPC method.
+type QueryYieldStateResponse struct {
+	// yield_per_second is the yield accrued per second at the current trust
+	// deposit value and yield rate.
+	YieldPerSecond cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=yield_per_second,json=yieldPerSecond,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"yield_per_second"`
+	// yield_per_block is the yield accrued per block at the block interval of
+	// the x/mint blocks_per_year.
+	YieldPerBlock      cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=yield_per_block,json=yieldPerBlock,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"yield_per_block"`
+	LastTransferAmount uint64                      `protobuf:"varint,3,opt,name=last_transfer_amount,json=lastTransferAmount,proto3" json:"last_transfer_amount,omitempty"`
+	LastTransferHeight int64                       `protobuf:"varint,4,opt,name=last_transfer_height,json=lastTransferHeight,proto3" json:"last_transfer_height,omitempty"`
+	LastTransferTime   time.Time                   `protobuf:"bytes,5,opt,name=last_transfer_time,json=lastTransferTime,proto3,stdtime" json:"last_transfer_time"`
+	// total_transferred is the yield moved to the trust deposit module since
+	// genesis.
+	TotalTransferred uint64 `protobuf:"varint,6,opt,name=total_transferred,json=totalTransferred,proto3" json:"total_transferred,omitempty"`
+	// yield_circuit holds the failures of the yield transfers and sweeps, and
+	// whether they are paused.
+	YieldCircuit YieldCircuit `protobuf:"bytes,7,opt,name=yield_circuit,json=yieldCircuit,proto3" json:"yield_circuit"`
+}
+
+func (m *QueryYieldStateResponse) Reset()         { *m = QueryYieldStateResponse{} }
+func (m *QueryYieldStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryYieldStateResponse) ProtoMessage()    {}
+func (*QueryYieldStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a899e63edc17efc, []int{15}
+}
+func (m *QueryYieldStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryYieldStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryYieldStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryYieldStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryYieldStateResponse.Merge(m, src)
+}
+func (m *QueryYieldStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryYieldStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryYieldStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryYieldStateResponse proto.InternalMessageInfo
+
+func (m *QueryYieldStateResponse) GetLastTransferAmount() uint64 {
+	if m != nil {
+		return m.LastTransferAmount
+	}
+	return 0
+}
+
+func (m *QueryYieldStateResponse) GetLastTransferHeight() int64 {
+	if m != nil {
+		return m.LastTransferHeight
+	}
+	return 0
+}
+
+func (m *QueryYieldStateResponse) GetLastTransferTime() time.Time {
+	if m != nil {
+		return m.LastTransferTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryYieldStateResponse) GetTotalTransferred() uint64 {
+	if m != nil {
+		return m.TotalTransferred
+	}
+	return 0
+}
+
+func (m *QueryYieldStateResponse) GetYieldCircuit() YieldCircuit {
+	if m != nil {
+		return m.YieldCircuit
+	}
+	return YieldCircuit{}
+}
+
+// QueryProjectedYieldRequest is request type for the Query/ProjectedYield RPC method.
+type QueryProjectedYieldRequest struct {
+	Duration time.Duration `protobuf:"bytes,1,opt,name=duration,proto3,stdduration" json:"duration"`
+}
+
+func (m *QueryProjectedYieldRequest) Reset()         { *m = QueryProjectedYieldRequest{} }
+func (m *QueryProjectedYieldRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedYieldRequest) ProtoMessage()    {}
+func (*QueryProjectedYieldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a899e63edc17efc, []int{16}
+}
+func (m *QueryProjectedYieldRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedYieldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedYieldRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedYieldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedYieldRequest.Merge(m, src)
+}
+func (m *QueryProjectedYieldRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedYieldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedYieldRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedYieldRequest proto.InternalMessageInfo
+
+func (m *QueryProjectedYieldRequest) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+// QueryProjectedYieldResponse is response type for the Query/ProjectedYield RPC method.
+type QueryProjectedYieldResponse struct {
+	Yield cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=yield,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"yield"`
+}
+
+func (m *QueryProjectedYieldResponse) Reset()         { *m = QueryProjectedYieldResponse{} }
+func (m *QueryProjectedYieldResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedYieldResponse) ProtoMessage()    {}
+func (*QueryProjectedYieldResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a899e63edc17efc, []int{17}
+}
+func (m *QueryProjectedYieldResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedYieldResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedYieldResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedYieldResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedYieldResponse.Merge(m, src)
+}
+func (m *QueryProjectedYieldResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedYieldResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedYieldResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedYieldResponse proto.InternalMessageInfo
+
+// QueryEffectiveAPRRequest is request type for the Query/EffectiveAPR RPC method.
+type QueryEffectiveAPRRequest struct {
+}
+
+func (m *QueryEffectiveAPRRequest) Reset()         { *m = QueryEffectiveAPRRequest{} }
+func (m *QueryEffectiveAPRRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveAPRRequest) ProtoMessage()    {}
+func (*QueryEffectiveAPRRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a899e63edc17efc, []int{18}
+}
+func (m *QueryEffectiveAPRRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveAPRRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveAPRRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveAPRRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveAPRRequest.Merge(m, src)
+}
+func (m *QueryEffectiveAPRRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveAPRRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveAPRRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveAPRRequest proto.InternalMessageInfo
+
+// QueryEffectiveAPRResponse is response type for the Query/EffectiveAPR RPC method.
+type QueryEffectiveAPRResponse struct {
+	// apr is the yield moved divided by the trust deposit value integrated over
+	// the time it accrued for, in years. It is zero before any yield accrued.
+	Apr cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=apr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"apr"`
+}
+
+func (m *QueryEffectiveAPRResponse) Reset()         { *m = QueryEffectiveAPRResponse{} }
+func (m *QueryEffectiveAPRResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveAPRResponse) ProtoMessage()    {}
+func (*QueryEffectiveAPRResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a899e63edc17efc, []int{19}
+}
+func (m *QueryEffectiveAPRResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveAPRResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveAPRResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveAPRResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveAPRResponse.Merge(m, src)
+}
+func (m *QueryEffectiveAPRResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveAPRResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveAPRResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveAPRResponse proto.InternalMessageInfo
+
+// QueryInvariantsRequest is request type for the Query/Invariants RPC method.
+type QueryInvariantsRequest struct {
+}
+
+func (m *QueryInvariantsRequest) Reset()         { *m = QueryInvariantsRequest{} }
+func (m *QueryInvariantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantsRequest) ProtoMessage()    {}
+func (*QueryInvariantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a899e63edc17efc, []int{20}
+}
+func (m *QueryInvariantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInvariantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantsRequest.Merge(m, src)
+}
+func (m *QueryInvariantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInvariantsRequest proto.InternalMessageInfo
+
+// QueryInvariantsResponse is response type for the Query/Invariants RPC method.
+type QueryInvariantsResponse struct {
+	Invariants []InvariantResult `protobuf:"bytes,1,rep,name=invariants,proto3" json:"invariants"`
+}
+
+func (m *QueryInvariantsResponse) Reset()         { *m = QueryInvariantsResponse{} }
+func (m *QueryInvariantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantsResponse) ProtoMessage()    {}
+func (*QueryInvariantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a899e63edc17efc, []int{21}
+}
+func (m *QueryInvariantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInvariantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantsResponse.Merge(m, src)
+}
+func (m *QueryInvariantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInvariantsResponse proto.InternalMessageInfo
+
+func (m *QueryInvariantsResponse) GetInvariants() []InvariantResult {
+	if m != nil {
+		return m.Invariants
+	}
+	return nil
+}
+
+// InvariantResult is the result of running an invariant.
+type InvariantResult struct {
+	// route is the crisis route of the invariant.
+	Route  string `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	Broken bool   `protobuf:"varint,2,opt,name=broken,proto3" json:"broken,omitempty"`
+	// message describes the state the invariant checked.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *InvariantResult) Reset()         { *m = InvariantResult{} }
+func (m *InvariantResult) String() string { return proto.CompactTextString(m) }
+func (*InvariantResult) ProtoMessage()    {}
+func (*InvariantResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a899e63edc17efc, []int{22}
+}
+func (m *InvariantResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantResult.Merge(m, src)
+}
+func (m *InvariantResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantResult proto.InternalMessageInfo
+
+func (m *InvariantResult) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *InvariantResult) GetBroken() bool {
+	if m != nil {
+		return m.Broken
+	}
+	return false
+}
+
+func (m *InvariantResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// QueryFundingGapRequest is request type for the Query/FundingGap RPC method.
+type QueryFundingGapRequest struct {
+}
+
+func (m *QueryFundingGapRequest) Reset()         { *m = QueryFundingGapRequest{} }
+func (m *QueryFundingGapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFundingGapRequest) ProtoMessage()    {}
+func (*QueryFundingGapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a899e63edc17efc, []int{23}
+}
+func (m *QueryFundingGapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundingGapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundingGapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFundingGapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundingGapRequest.Merge(m, src)
+}
+func (m *QueryFundingGapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundingGapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundingGapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFundingGapRequest proto.InternalMessageInfo
+
+// QueryFundingGapResponse is response type for the Query/FundingGap RPC method.
+// Amounts are yearly, in the params denom.
+type QueryFundingGapResponse struct {
+	// required_yield is the yield the trust deposits earn in a year at the
+	// current trust deposit value and yield rate.
+	RequiredYield cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=required_yield,json=requiredYield,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"required_yield"`
+	// community_pool_inflow is the yearly inflow the continuous funds stream a
+	// percentage of: the x/mint annual provisions times the x/distribution
+	// community tax.
+	CommunityPoolInflow cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=community_pool_inflow,json=communityPoolInflow,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool_inflow"`
+	// community_pool is the balance of the community pool.
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=community_pool,json=communityPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"community_pool"`
+	// required_percentage is the continuous fund percentage that streams the
+	// required yield, capped at the percentage other continuous funds leave.
+	RequiredPercentage cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=required_percentage,json=requiredPercentage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"required_percentage"`
+	// current_percentage is the percentage of the verana_pool continuous fund,
+	// zero if there is none.
+	CurrentPercentage cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=current_percentage,json=currentPercentage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"current_percentage"`
+	// gap is the required yield minus what the current continuous fund streams,
+	// negative on a surplus.
+	Gap cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=gap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"gap"`
+}
+
+func (m *QueryFundingGapResponse) Reset()         { *m = QueryFundingGapResponse{} }
+func (m *QueryFundingGapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFundingGapResponse) ProtoMessage()    {}
+func (*QueryFundingGapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a899e63edc17efc, []int{24}
+}
+func (m *QueryFundingGapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundingGapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundingGapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFundingGapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundingGapResponse.Merge(m, src)
+}
+func (m *QueryFundingGapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundingGapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundingGapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFundingGapResponse proto.InternalMessageInfo
+
+func (m *QueryFundingGapResponse) GetCommunityPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CommunityPool
+	}
+	return nil
+}
+
+// QueryYieldShortfallRequest is request type for the Query/YieldShortfall RPC method.
+type QueryYieldShortfallRequest struct {
+}
+
+func (m *QueryYieldShortfallRequest) Reset()         { *m = QueryYieldShortfallRequest{} }
+func (m *QueryYieldShortfallRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryYieldShortfallRequest) ProtoMessage()    {}
+func (*QueryYieldShortfallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a899e63edc17efc, []int{25}
+}
+func (m *QueryYieldShortfallRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryYieldShortfallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryYieldShortfallRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryYieldShortfallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryYieldShortfallRequest.Merge(m, src)
+}
+func (m *QueryYieldShortfallRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryYieldShortfallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryYieldShortfallRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryYieldShortfallRequest proto.InternalMessageInfo
+
+// QueryYieldShortfallResponse is response type for the Query/YieldShortfall RPC method.
+type QueryYieldShortfallResponse struct {
+	YieldShortfall YieldShortfall `protobuf:"bytes,1,opt,name=yield_shortfall,json=yieldShortfall,proto3" json:"yield_shortfall"`
+}
+
+func (m *QueryYieldShortfallResponse) Reset()         { *m = QueryYieldShortfallResponse{} }
+func (m *QueryYieldShortfallResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryYieldShortfallResponse) ProtoMessage()    {}
+func (*QueryYieldShortfallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a899e63edc17efc, []int{26}
+}
+func (m *QueryYieldShortfallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryYieldShortfallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryYieldShortfallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryYieldShortfallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryYieldShortfallResponse.Merge(m, src)
+}
+func (m *QueryYieldShortfallResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryYieldShortfallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryYieldShortfallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryYieldShortfallResponse proto.InternalMessageInfo
+
+func (m *QueryYieldShortfallResponse) GetYieldShortfall() YieldShortfall {
+	if m != nil {
+		return m.YieldShortfall
+	}
+	return YieldShortfall{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "veranatest.td.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "veranatest.td.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryFeeSourcedFundingRequest)(nil), "veranatest.td.v1.QueryFeeSourcedFundingRequest")
+	proto.RegisterType((*QueryFeeSourcedFundingResponse)(nil), "veranatest.td.v1.QueryFeeSourcedFundingResponse")
+	proto.RegisterType((*QueryTrustDepositRequest)(nil), "veranatest.td.v1.QueryTrustDepositRequest")
+	proto.RegisterType((*QueryTrustDepositResponse)(nil), "veranatest.td.v1.QueryTrustDepositResponse")
+	proto.RegisterType((*QueryPendingWithdrawalsRequest)(nil), "veranatest.td.v1.QueryPendingWithdrawalsRequest")
+	proto.RegisterType((*QueryPendingWithdrawalsResponse)(nil), "veranatest.td.v1.QueryPendingWithdrawalsResponse")
+	proto.RegisterType((*QuerySlashHistoryRequest)(nil), "veranatest.td.v1.QuerySlashHistoryRequest")
+	proto.RegisterType((*QuerySlashHistoryResponse)(nil), "veranatest.td.v1.QuerySlashHistoryResponse")
+	proto.RegisterType((*QueryDustAmountRequest)(nil), "veranatest.td.v1.QueryDustAmountRequest")
+	proto.RegisterType((*QueryDustAmountResponse)(nil), "veranatest.td.v1.QueryDustAmountResponse")
+	proto.RegisterType((*QueryModuleBalancesRequest)(nil), "veranatest.td.v1.QueryModuleBalancesRequest")
+	proto.RegisterType((*QueryModuleBalancesResponse)(nil), "veranatest.td.v1.QueryModuleBalancesResponse")
+	proto.RegisterType((*QueryYieldStateRequest)(nil), "veranatest.td.v1.QueryYieldStateRequest")
+	proto.RegisterType((*QueryYieldStateResponse)(nil), "veranatest.td.v1.QueryYieldStateResponse")
+	proto.RegisterType((*QueryProjectedYieldRequest)(nil), "veranatest.td.v1.QueryProjectedYieldRequest")
+	proto.RegisterType((*QueryProjectedYieldResponse)(nil), "veranatest.td.v1.QueryProjectedYieldResponse")
+	proto.RegisterType((*QueryEffectiveAPRRequest)(nil), "veranatest.td.v1.QueryEffectiveAPRRequest")
+	proto.RegisterType((*QueryEffectiveAPRResponse)(nil), "veranatest.td.v1.QueryEffectiveAPRResponse")
+	proto.RegisterType((*QueryInvariantsRequest)(nil), "veranatest.td.v1.QueryInvariantsRequest")
+	proto.RegisterType((*QueryInvariantsResponse)(nil), "veranatest.td.v1.QueryInvariantsResponse")
+	proto.RegisterType((*InvariantResult)(nil), "veranatest.td.v1.InvariantResult")
+	proto.RegisterType((*QueryFundingGapRequest)(nil), "veranatest.td.v1.QueryFundingGapRequest")
+	proto.RegisterType((*QueryFundingGapResponse)(nil), "veranatest.td.v1.QueryFundingGapResponse")
+	proto.RegisterType((*QueryYieldShortfallRequest)(nil), "veranatest.td.v1.QueryYieldShortfallRequest")
+	proto.RegisterType((*QueryYieldShortfallResponse)(nil), "veranatest.td.v1.QueryYieldShortfallResponse")
+}
+
+func init() { proto.RegisterFile("veranatest/td/v1/query.proto", fileDescriptor_1a899e63edc17efc) }
+
+var fileDescriptor_1a899e63edc17efc = []byte{
+	// 1692 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0xdb, 0xc8,
+	0x15, 0x36, 0x2d, 0xdb, 0x49, 0xc6, 0xb1, 0x63, 0x8f, 0x9d, 0x98, 0xa6, 0x6d, 0xc9, 0x51, 0x92,
+	0xc6, 0x8e, 0x6b, 0x31, 0x76, 0x11, 0x04, 0x45, 0x0f, 0x45, 0x14, 0x37, 0x3f, 0xda, 0x26, 0x50,
+	0x65, 0xa3, 0x69, 0x0a, 0x14, 0xec, 0x88, 0x1c, 0x49, 0xac, 0x29, 0x0e, 0x3d, 0x33, 0xb4, 0x2b,
+	0x14, 0xbd, 0xf4, 0xd2, 0xa2, 0xa7, 0x00, 0x05, 0x8a, 0xa0, 0xe8, 0xb5, 0x45, 0xd1, 0x53, 0xd1,
+	0xdd, 0xff, 0x61, 0x03, 0xec, 0x25, 0xd8, 0xbd, 0x2c, 0xf6, 0x90, 0x2c, 0x92, 0x05, 0x16, 0xd8,
+	0xe3, 0xfe, 0x05, 0x0b, 0xce, 0x0c, 0x25, 0xca, 0x14, 0x61, 0x1a, 0xbb, 0x01, 0xf6, 0x62, 0x8b,
+	0x7c, 0xef, 0x7b, 0xef, 0x9b, 0x6f, 0x1e, 0xdf, 0xbc, 0x01, 0xcb, 0x87, 0x98, 0x22, 0x1f, 0x71,
+	0xcc, 0xb8, 0xc9, 0x1d, 0xf3, 0x70, 0xcb, 0x3c, 0x08, 0x31, 0xed, 0x56, 0x02, 0x4a, 0x38, 0x81,
+	0x33, 0x7d, 0x6b, 0x85, 0x3b, 0x95, 0xc3, 0x2d, 0x63, 0x16, 0x75, 0x5c, 0x9f, 0x98, 0xe2, 0xaf,
+	0x74, 0x32, 0x6e, 0xd8, 0x84, 0x75, 0x08, 0x33, 0x1b, 0x88, 0x61, 0x89, 0x36, 0x0f, 0xb7, 0x1a,
+	0x98, 0xa3, 0x2d, 0x33, 0x40, 0x2d, 0xd7, 0x47, 0xdc, 0x25, 0xbe, 0xf2, 0x2d, 0x26, 0x7d, 0x63,
+	0x2f, 0x9b, 0xb8, 0xb1, 0x7d, 0xbe, 0x45, 0x5a, 0x44, 0xfc, 0x34, 0xa3, 0x5f, 0xea, 0xed, 0x72,
+	0x8b, 0x90, 0x96, 0x87, 0x4d, 0x14, 0xb8, 0x26, 0xf2, 0x7d, 0xc2, 0x45, 0x48, 0x16, 0xc7, 0x54,
+	0x56, 0xf1, 0xd4, 0x08, 0x9b, 0xa6, 0x13, 0xd2, 0x64, 0xce, 0xd2, 0x71, 0x3b, 0x77, 0x3b, 0x98,
+	0x71, 0xd4, 0x09, 0x94, 0xc3, 0xa2, 0x24, 0x65, 0xc9, 0xbc, 0xf2, 0x41, 0x99, 0x56, 0x52, 0xf2,
+	0x04, 0x88, 0xa2, 0x4e, 0x6c, 0x4e, 0xab, 0xc7, 0xbb, 0x01, 0x56, 0xd6, 0xf2, 0x3c, 0x80, 0xbf,
+	0x88, 0xe4, 0xa8, 0x09, 0x48, 0x1d, 0x1f, 0x84, 0x98, 0xf1, 0x72, 0x1d, 0xcc, 0x0d, 0xbc, 0x65,
+	0x01, 0xf1, 0x19, 0x86, 0x3f, 0x02, 0x13, 0x32, 0xb4, 0xae, 0xad, 0x6a, 0x6b, 0x93, 0xdb, 0x7a,
+	0xe5, 0xb8, 0xf6, 0x15, 0x89, 0xa8, 0x9e, 0x7b, 0xf1, 0xaa, 0x34, 0xf2, 0x9f, 0x2f, 0xfe, 0x77,
+	0x43, 0xab, 0x2b, 0x48, 0xb9, 0x04, 0x56, 0x44, 0xcc, 0x7b, 0x18, 0xef, 0x92, 0x90, 0xda, 0xd8,
+	0xb9, 0x17, 0xfa, 0x8e, 0xeb, 0xb7, 0xe2, 0xa4, 0x7f, 0xd5, 0x40, 0x31, 0xcb, 0x43, 0x11, 0x68,
+	0x83, 0x09, 0xd4, 0x21, 0xa1, 0xcf, 0x75, 0x6d, 0xb5, 0xb0, 0x36, 0xb9, 0xbd, 0x58, 0x51, 0x4a,
+	0x44, 0x7b, 0x55, 0x51, 0x7b, 0x55, 0xb9, 0x4b, 0x5c, 0xbf, 0x7a, 0x2b, 0x62, 0xf0, 0xdf, 0xd7,
+	0xa5, 0xb5, 0x96, 0xcb, 0xdb, 0x61, 0xa3, 0x62, 0x93, 0x8e, 0x92, 0x4d, 0xfd, 0xdb, 0x64, 0xce,
+	0xbe, 0x92, 0x22, 0x02, 0x30, 0xc5, 0x56, 0xc6, 0x2f, 0x3f, 0x06, 0xba, 0xe0, 0xb2, 0x47, 0x43,
+	0xc6, 0x77, 0x70, 0x40, 0x98, 0xcb, 0x15, 0x51, 0xb8, 0x0d, 0xce, 0x20, 0xdb, 0x56, 0x34, 0xb4,
+	0xb5, 0x73, 0x55, 0xfd, 0xa3, 0xf7, 0x37, 0xe7, 0x15, 0x93, 0x3b, 0x8e, 0x43, 0x31, 0x63, 0xbb,
+	0x9c, 0x46, 0xc4, 0x63, 0xc7, 0xf2, 0x07, 0x1a, 0x58, 0x1c, 0x12, 0x50, 0xad, 0xeb, 0x31, 0x98,
+	0xe2, 0xd1, 0x7b, 0xcb, 0x91, 0x06, 0xa5, 0x6f, 0x31, 0xad, 0x6f, 0x12, 0x9e, 0x54, 0xf9, 0x3c,
+	0x4f, 0x18, 0xe0, 0x3c, 0x18, 0x3f, 0x44, 0x5e, 0x88, 0xf5, 0xd1, 0x55, 0x6d, 0x6d, 0xac, 0x2e,
+	0x1f, 0xe0, 0x0e, 0x98, 0x64, 0x6d, 0x44, 0xb1, 0x25, 0x6d, 0x05, 0xc1, 0xfd, 0x4a, 0x14, 0xe3,
+	0xd3, 0x57, 0xa5, 0x25, 0xc9, 0x9f, 0x39, 0xfb, 0x15, 0x97, 0x98, 0x1d, 0xc4, 0xdb, 0x95, 0x9f,
+	0xe3, 0x16, 0xb2, 0xbb, 0x3b, 0xd8, 0xae, 0x03, 0x81, 0xfb, 0x65, 0x04, 0x2b, 0xff, 0x33, 0xde,
+	0xa6, 0x1a, 0x16, 0x9b, 0xf3, 0xc4, 0xe5, 0x6d, 0x87, 0xa2, 0x23, 0xe4, 0xb1, 0x6f, 0x20, 0x10,
+	0xbc, 0x07, 0x40, 0xff, 0x4b, 0x14, 0xbc, 0x27, 0xb7, 0xbf, 0x37, 0xb0, 0xbd, 0xf2, 0xa3, 0x8f,
+	0x37, 0xb9, 0x86, 0x5a, 0x58, 0xe5, 0xab, 0x27, 0x90, 0xe5, 0x0f, 0x35, 0x50, 0xca, 0xa4, 0xa7,
+	0xe4, 0xb6, 0xc0, 0x5c, 0x20, 0xad, 0xd6, 0x51, 0xdf, 0xac, 0x6a, 0xea, 0xca, 0x90, 0xa2, 0x3e,
+	0x1e, 0x2a, 0xa9, 0x3c, 0x0c, 0x52, 0x89, 0xe0, 0xfd, 0x21, 0x8b, 0xb9, 0x7e, 0xe2, 0x62, 0x24,
+	0xbb, 0x81, 0xd5, 0xfc, 0x5d, 0x53, 0x75, 0xb8, 0xeb, 0x21, 0xd6, 0x7e, 0xe0, 0x32, 0x4e, 0x68,
+	0xf7, 0xbb, 0x20, 0xf3, 0x7b, 0x71, 0x3d, 0x0f, 0x12, 0x53, 0x02, 0x3f, 0x02, 0x53, 0x2c, 0x7a,
+	0x6f, 0x51, 0x6c, 0x13, 0xea, 0xc4, 0xd2, 0xae, 0xa4, 0xa5, 0x15, 0xf0, 0xba, 0xf0, 0x1a, 0x28,
+	0x67, 0xd6, 0x7f, 0xff, 0x2d, 0xca, 0xa9, 0x83, 0x4b, 0x82, 0xf4, 0x4e, 0xc8, 0xf8, 0x1d, 0xf1,
+	0xa1, 0xf7, 0x3b, 0xde, 0x42, 0xca, 0xa2, 0x16, 0x73, 0x1b, 0x8c, 0x39, 0x21, 0x8b, 0x35, 0xce,
+	0xf5, 0xbd, 0x08, 0x40, 0x79, 0x19, 0x18, 0x22, 0xe6, 0x23, 0xe2, 0x84, 0x1e, 0xae, 0x22, 0x0f,
+	0xf9, 0x36, 0xee, 0xf5, 0xd8, 0xaf, 0x34, 0xb0, 0x34, 0xd4, 0xac, 0xd2, 0xfe, 0x16, 0x8c, 0x72,
+	0xe7, 0x9d, 0xf5, 0xb9, 0x51, 0xee, 0xc0, 0x03, 0x30, 0x29, 0xf7, 0xc3, 0x0a, 0x08, 0xf1, 0xf4,
+	0xd1, 0x77, 0x94, 0x0a, 0xc8, 0x24, 0x35, 0x42, 0xbc, 0xde, 0x06, 0x3c, 0x75, 0xb1, 0xe7, 0xec,
+	0x72, 0xc4, 0xe3, 0xe2, 0x2a, 0x7f, 0x59, 0x00, 0x0b, 0x29, 0x53, 0xaf, 0x9c, 0x66, 0xba, 0xd1,
+	0x5b, 0x2b, 0xc0, 0xd4, 0x62, 0xd8, 0x26, 0xbe, 0x73, 0x9a, 0xdd, 0x98, 0x16, 0xe0, 0x1a, 0xa6,
+	0xbb, 0x02, 0x0a, 0x7f, 0x06, 0x2e, 0xf4, 0xc3, 0x35, 0x3c, 0x62, 0xef, 0xeb, 0xa3, 0xf9, 0xa3,
+	0x4d, 0xc5, 0xd1, 0xaa, 0x11, 0x12, 0xde, 0x04, 0xf3, 0x1e, 0x62, 0xdc, 0xe2, 0x14, 0xf9, 0xac,
+	0x89, 0xa9, 0xa5, 0x0e, 0xa8, 0x82, 0xe8, 0xbc, 0x30, 0xb2, 0xed, 0x29, 0x93, 0xac, 0xab, 0x34,
+	0xa2, 0x8d, 0xdd, 0x56, 0x9b, 0xeb, 0x63, 0xab, 0xda, 0x5a, 0x61, 0x10, 0xf1, 0x40, 0x58, 0x60,
+	0x1d, 0xc0, 0x41, 0x44, 0x34, 0x1d, 0xe8, 0xe3, 0xe2, 0x3b, 0x30, 0x2a, 0x72, 0x74, 0xa8, 0xc4,
+	0xa3, 0x43, 0x65, 0x2f, 0x1e, 0x1d, 0xaa, 0x67, 0xa3, 0xf5, 0x3c, 0x7b, 0x5d, 0xd2, 0xea, 0x33,
+	0xc9, 0xa8, 0x91, 0x03, 0xdc, 0x00, 0xb3, 0x9c, 0x70, 0xe4, 0xf5, 0x82, 0x52, 0xec, 0xe8, 0x13,
+	0x82, 0xf4, 0x8c, 0x30, 0xec, 0xf5, 0xdf, 0x47, 0xe7, 0x93, 0x54, 0xcc, 0x76, 0xa9, 0x1d, 0xba,
+	0x5c, 0x3f, 0x93, 0x75, 0x3e, 0x89, 0xdd, 0xbb, 0x2b, 0xbd, 0x06, 0x3e, 0xe8, 0x6e, 0xc2, 0x50,
+	0xfe, 0x8d, 0xfa, 0x32, 0x6a, 0x94, 0xfc, 0x0e, 0xdb, 0x1c, 0x3b, 0x02, 0x16, 0xf7, 0xb5, 0x1f,
+	0x83, 0xb3, 0xf1, 0x78, 0xa4, 0x0e, 0xc2, 0xc5, 0xd4, 0x22, 0x77, 0x94, 0x83, 0x5c, 0xe3, 0xf3,
+	0x68, 0x8d, 0x3d, 0x50, 0xf9, 0x57, 0x60, 0x69, 0x68, 0x78, 0x55, 0x4e, 0x3f, 0x04, 0xe3, 0x82,
+	0xcd, 0x69, 0x6a, 0x48, 0x22, 0xca, 0x86, 0x6a, 0xc7, 0x3f, 0x69, 0x36, 0xb1, 0xcd, 0xdd, 0x43,
+	0x7c, 0xa7, 0x56, 0xef, 0xb7, 0x90, 0xc5, 0x21, 0x36, 0x95, 0xf3, 0x16, 0x28, 0xa0, 0x80, 0x9e,
+	0x26, 0x63, 0xe4, 0xdf, 0xfb, 0x5e, 0x1e, 0xfa, 0x87, 0x88, 0xba, 0xc8, 0xe7, 0xbd, 0xf6, 0xd1,
+	0x00, 0x0b, 0x29, 0x8b, 0xca, 0x75, 0x1f, 0x00, 0xb7, 0xf7, 0x56, 0x75, 0x90, 0xcb, 0xe9, 0xad,
+	0xea, 0x21, 0xeb, 0x98, 0x85, 0x1e, 0xaf, 0x8e, 0x45, 0xac, 0xea, 0x09, 0x68, 0xf9, 0x29, 0xb8,
+	0x70, 0xcc, 0x29, 0x9a, 0x2c, 0x28, 0x09, 0x39, 0x96, 0x2b, 0xa9, 0xcb, 0x07, 0x78, 0x09, 0x4c,
+	0x34, 0x28, 0xd9, 0xc7, 0xb2, 0x39, 0x9f, 0xad, 0xab, 0x27, 0xa8, 0x83, 0x33, 0x1d, 0xcc, 0x18,
+	0x6a, 0xa9, 0x69, 0xa3, 0x1e, 0x3f, 0xf6, 0x16, 0xa6, 0x26, 0xbc, 0xfb, 0x28, 0x88, 0x17, 0xf6,
+	0x7c, 0x0c, 0x2c, 0xa4, 0x4c, 0x6a, 0x65, 0x3f, 0x05, 0xd3, 0x14, 0x1f, 0x84, 0x2e, 0xc5, 0x8e,
+	0x75, 0xea, 0x2d, 0x9c, 0x8a, 0xa1, 0xa2, 0x1a, 0xe0, 0x13, 0x70, 0xd1, 0x26, 0x9d, 0x4e, 0xe8,
+	0xbb, 0xbc, 0x2b, 0x1a, 0xa0, 0xe5, 0xfa, 0x4d, 0x8f, 0x1c, 0x9d, 0xa6, 0x17, 0xcc, 0xf5, 0x22,
+	0x44, 0xdd, 0xed, 0xa1, 0xc0, 0xc3, 0x23, 0x30, 0x3d, 0x18, 0x58, 0x2f, 0xbc, 0xa3, 0xce, 0x3a,
+	0x35, 0x90, 0x1e, 0xee, 0x81, 0xb9, 0x9e, 0x3a, 0x01, 0xa6, 0x36, 0xf6, 0x79, 0xa4, 0xfc, 0x58,
+	0xfe, 0xf5, 0xc0, 0x18, 0x5f, 0xeb, 0xc1, 0xa3, 0xe6, 0x63, 0x87, 0x94, 0x62, 0x9f, 0x27, 0x83,
+	0x8e, 0xe7, 0x0f, 0x3a, 0xab, 0xe0, 0x89, 0x98, 0xb7, 0x40, 0xa1, 0x85, 0x02, 0x7d, 0x22, 0x7f,
+	0x90, 0xc8, 0xbf, 0x77, 0xa0, 0xca, 0x23, 0xa2, 0x4d, 0x28, 0x6f, 0x22, 0xcf, 0x8b, 0x0b, 0x87,
+	0x81, 0xa5, 0xa1, 0x56, 0x55, 0x3b, 0x7b, 0x71, 0xd7, 0x67, 0xb1, 0x49, 0x35, 0x97, 0xd5, 0x8c,
+	0x2e, 0xd6, 0x0b, 0x91, 0xec, 0x63, 0xd3, 0xdd, 0x01, 0xd3, 0xf6, 0xbf, 0x2f, 0x80, 0x71, 0x91,
+	0x15, 0x1e, 0x81, 0x09, 0x79, 0xf9, 0x81, 0x57, 0xd3, 0x01, 0xd3, 0x77, 0x2c, 0xe3, 0xda, 0x09,
+	0x5e, 0x92, 0x76, 0x79, 0xf5, 0x4f, 0x1f, 0x7f, 0xfe, 0xb7, 0x51, 0x03, 0xea, 0x66, 0xc6, 0x35,
+	0x0f, 0xfe, 0x4b, 0x03, 0xb3, 0xa9, 0x2b, 0x13, 0x34, 0x33, 0xc2, 0x67, 0x5d, 0xbf, 0x8c, 0x9b,
+	0xf9, 0x01, 0x8a, 0xda, 0xa6, 0xa0, 0x76, 0x1d, 0x5e, 0x4b, 0x53, 0x6b, 0x62, 0x6c, 0x31, 0x89,
+	0xb2, 0x9a, 0x8a, 0xd1, 0x3f, 0x34, 0x70, 0x3e, 0x79, 0x7d, 0x81, 0x37, 0x32, 0x32, 0x0e, 0xb9,
+	0x73, 0x19, 0x1b, 0xb9, 0x7c, 0x15, 0xb1, 0x2d, 0x41, 0x6c, 0x03, 0xae, 0xa7, 0x89, 0x0d, 0x5c,
+	0xb3, 0xcc, 0x3f, 0xa8, 0xb1, 0xf8, 0x8f, 0xf0, 0xff, 0x1a, 0x80, 0xe9, 0x1b, 0x03, 0xcc, 0x12,
+	0x25, 0xf3, 0xee, 0x63, 0x6c, 0x9d, 0x02, 0xa1, 0xe8, 0xde, 0x16, 0x74, 0xb7, 0xa0, 0x39, 0x64,
+	0x8b, 0xd3, 0xd7, 0x94, 0x04, 0xe9, 0x48, 0xd1, 0xe4, 0xfc, 0x9d, 0xa9, 0xe8, 0x90, 0xdb, 0x83,
+	0xb1, 0x91, 0xcb, 0xf7, 0x64, 0x45, 0xe5, 0xa0, 0xdf, 0x96, 0x80, 0x04, 0xb9, 0xbf, 0x68, 0x00,
+	0xf4, 0xa7, 0x69, 0xb8, 0x96, 0x91, 0x2e, 0x35, 0x8a, 0x1b, 0xeb, 0x39, 0x3c, 0x15, 0xad, 0x6b,
+	0x82, 0x56, 0x09, 0xae, 0xa4, 0x69, 0x45, 0x13, 0xb8, 0x9a, 0xc5, 0xe0, 0x73, 0x0d, 0x4c, 0x0f,
+	0x4e, 0xd9, 0xf0, 0xfb, 0x19, 0x49, 0x86, 0xce, 0xea, 0xc6, 0x66, 0x4e, 0x6f, 0x45, 0x6b, 0x5d,
+	0xd0, 0xba, 0x02, 0x2f, 0xa7, 0x69, 0x75, 0x04, 0xc2, 0x6a, 0xc4, 0x3c, 0x22, 0x95, 0xfa, 0x13,
+	0x6f, 0xa6, 0x4a, 0xa9, 0x79, 0xd9, 0x58, 0xcf, 0xe1, 0x79, 0xb2, 0x4a, 0xaa, 0x23, 0x8a, 0xdc,
+	0x91, 0x4a, 0x83, 0x13, 0x53, 0xa6, 0x4a, 0x43, 0xe7, 0x36, 0x63, 0x33, 0xa7, 0xf7, 0xc9, 0x2a,
+	0x05, 0x31, 0x42, 0x9e, 0xf2, 0xf0, 0x99, 0x06, 0xce, 0x27, 0xc7, 0xaa, 0xcc, 0x42, 0x1f, 0x32,
+	0x97, 0x19, 0x1b, 0xb9, 0x7c, 0x15, 0xa9, 0xeb, 0x82, 0xd4, 0x65, 0x58, 0x4a, 0x93, 0xc2, 0xb1,
+	0xbf, 0x85, 0x02, 0x0a, 0xff, 0xac, 0x01, 0xd0, 0x9f, 0xbd, 0x32, 0x37, 0x2e, 0x35, 0xb8, 0x19,
+	0xeb, 0x39, 0x3c, 0x15, 0x99, 0xab, 0x82, 0x4c, 0x11, 0x2e, 0xa7, 0xc9, 0xf4, 0xa7, 0x34, 0x51,
+	0x42, 0xfd, 0x59, 0x29, 0x93, 0x49, 0x6a, 0xd2, 0x32, 0xd6, 0x73, 0x78, 0x9e, 0x5c, 0x42, 0xaa,
+	0xbd, 0x5b, 0x2d, 0x14, 0x88, 0x12, 0x1a, 0x3c, 0x3b, 0x33, 0x4b, 0x68, 0xe8, 0x19, 0x6e, 0x6c,
+	0xe6, 0xf4, 0x3e, 0xb9, 0x84, 0x8e, 0x9d, 0xf5, 0x55, 0xf3, 0xc5, 0x9b, 0xa2, 0xf6, 0xf2, 0x4d,
+	0x51, 0xfb, 0xec, 0x4d, 0x51, 0x7b, 0xf6, 0xb6, 0x38, 0xf2, 0xf2, 0x6d, 0x71, 0xe4, 0x93, 0xb7,
+	0xc5, 0x91, 0x5f, 0x5f, 0x4c, 0x60, 0x7f, 0x1f, 0xa1, 0xc5, 0x9c, 0xd5, 0x98, 0x10, 0x77, 0x8d,
+	0x1f, 0x7c, 0x3d, 0x00, 0x22, 0xeb, 0x81, 0x52, 0x7e, 0x16, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// FeeSourcedFunding queries the total amount of transaction fees moved to
+	// the trust deposit module.
+	FeeSourcedFunding(ctx context.Context, in *QueryFeeSourcedFundingRequest, opts ...grpc.CallOption) (*QueryFeeSourcedFundingResponse, error)
+	// TrustDeposit queries the trust deposit of an account and its value at the
+	// current share value.
+	TrustDeposit(ctx context.Context, in *QueryTrustDepositRequest, opts ...grpc.CallOption) (*QueryTrustDepositResponse, error)
+	// PendingWithdrawals queries the reclaimed trust deposits of an account that
+	// have not been paid out yet.
+	PendingWithdrawals(ctx context.Context, in *QueryPendingWithdrawalsRequest, opts ...grpc.CallOption) (*QueryPendingWithdrawalsResponse, error)
+	// SlashHistory queries the slashes of the trust deposit of an account.
+	SlashHistory(ctx context.Context, in *QuerySlashHistoryRequest, opts ...grpc.CallOption) (*QuerySlashHistoryResponse, error)
+	// DustAmount queries the yield accrued but not yet moved, below one unit of
+	// the yield denom.
+	DustAmount(ctx context.Context, in *QueryDustAmountRequest, opts ...grpc.CallOption) (*QueryDustAmountResponse, error)
+	// ModuleBalances queries the balances of the td and verana_pool module
+	// accounts.
+	ModuleBalances(ctx context.Context, in *QueryModuleBalancesRequest, opts ...grpc.CallOption) (*QueryModuleBalancesResponse, error)
+	// YieldState queries the current yield rate and the yield moved so far.
+	YieldState(ctx context.Context, in *QueryYieldStateRequest, opts ...grpc.CallOption) (*QueryYieldStateResponse, error)
+	// ProjectedYield queries the yield the current trust deposit value earns
+	// over a duration at the current yield rate.
+	ProjectedYield(ctx context.Context, in *QueryProjectedYieldRequest, opts ...grpc.CallOption) (*QueryProjectedYieldResponse, error)
+	// EffectiveAPR queries the annual yield rate actually paid, computed from
+	// the yield moved to the trust deposit module.
+	EffectiveAPR(ctx context.Context, in *QueryEffectiveAPRRequest, opts ...grpc.CallOption) (*QueryEffectiveAPRResponse, error)
+	// Invariants runs the td module invariants and reports which are broken.
+	Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error)
+	// FundingGap compares the yield the trust deposits require with what the
+	// verana_pool continuous fund streams.
+	FundingGap(ctx context.Context, in *QueryFundingGapRequest, opts ...grpc.CallOption) (*QueryFundingGapResponse, error)
+	// YieldShortfall queries the yield verana_pool could not pay and still owes
+	// to the trust deposits.
+	YieldShortfall(ctx context.Context, in *QueryYieldShortfallRequest, opts ...grpc.CallOption) (*QueryYieldShortfallResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/veranatest.td.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeSourcedFunding(ctx context.Context, in *QueryFeeSourcedFundingRequest, opts ...grpc.CallOption) (*QueryFeeSourcedFundingResponse, error) {
+	out := new(QueryFeeSourcedFundingResponse)
+	err := c.cc.Invoke(ctx, "/veranatest.td.v1.Query/FeeSourcedFunding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TrustDeposit(ctx context.Context, in *QueryTrustDepositRequest, opts ...grpc.CallOption) (*QueryTrustDepositResponse, error) {
+	out := new(QueryTrustDepositResponse)
+	err := c.cc.Invoke(ctx, "/veranatest.td.v1.Query/TrustDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingWithdrawals(ctx context.Context, in *QueryPendingWithdrawalsRequest, opts ...grpc.CallOption) (*QueryPendingWithdrawalsResponse, error) {
+	out := new(QueryPendingWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, "/veranatest.td.v1.Query/PendingWithdrawals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SlashHistory(ctx context.Context, in *QuerySlashHistoryRequest, opts ...grpc.CallOption) (*QuerySlashHistoryResponse, error) {
+	out := new(QuerySlashHistoryResponse)
+	err := c.cc.Invoke(ctx, "/veranatest.td.v1.Query/SlashHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DustAmount(ctx context.Context, in *QueryDustAmountRequest, opts ...grpc.CallOption) (*QueryDustAmountResponse, error) {
+	out := new(QueryDustAmountResponse)
+	err := c.cc.Invoke(ctx, "/veranatest.td.v1.Query/DustAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleBalances(ctx context.Context, in *QueryModuleBalancesRequest, opts ...grpc.CallOption) (*QueryModuleBalancesResponse, error) {
+	out := new(QueryModuleBalancesResponse)
+	err := c.cc.Invoke(ctx, "/veranatest.td.v1.Query/ModuleBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) YieldState(ctx context.Context, in *QueryYieldStateRequest, opts ...grpc.CallOption) (*QueryYieldStateResponse, error) {
+	out := new(QueryYieldStateResponse)
+	err := c.cc.Invoke(ctx, "/veranatest.td.v1.Query/YieldState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectedYield(ctx context.Context, in *QueryProjectedYieldRequest, opts ...grpc.CallOption) (*QueryProjectedYieldResponse, error) {
+	out := new(QueryProjectedYieldResponse)
+	err := c.cc.Invoke(ctx, "/veranatest.td.v1.Query/ProjectedYield", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EffectiveAPR(ctx context.Context, in *QueryEffectiveAPRRequest, opts ...grpc.CallOption) (*QueryEffectiveAPRResponse, error) {
+	out := new(QueryEffectiveAPRResponse)
+	err := c.cc.Invoke(ctx, "/veranatest.td.v1.Query/EffectiveAPR", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error) {
+	out := new(QueryInvariantsResponse)
+	err := c.cc.Invoke(ctx, "/veranatest.td.v1.Query/Invariants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FundingGap(ctx context.Context, in *QueryFundingGapRequest, opts ...grpc.CallOption) (*QueryFundingGapResponse, error) {
+	out := new(QueryFundingGapResponse)
+	err := c.cc.Invoke(ctx, "/veranatest.td.v1.Query/FundingGap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) YieldShortfall(ctx context.Context, in *QueryYieldShortfallRequest, opts ...grpc.CallOption) (*QueryYieldShortfallResponse, error) {
+	out := new(QueryYieldShortfallResponse)
+	err := c.cc.Invoke(ctx, "/veranatest.td.v1.Query/YieldShortfall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// FeeSourcedFunding queries the total amount of transaction fees moved to
+	// the trust deposit module.
+	FeeSourcedFunding(context.Context, *QueryFeeSourcedFundingRequest) (*QueryFeeSourcedFundingResponse, error)
+	// TrustDeposit queries the trust deposit of an account and its value at the
+	// current share value.
+	TrustDeposit(context.Context, *QueryTrustDepositRequest) (*QueryTrustDepositResponse, error)
+	// PendingWithdrawals queries the reclaimed trust deposits of an account that
+	// have not been paid out yet.
+	PendingWithdrawals(context.Context, *QueryPendingWithdrawalsRequest) (*QueryPendingWithdrawalsResponse, error)
+	// SlashHistory queries the slashes of the trust deposit of an account.
+	SlashHistory(context.Context, *QuerySlashHistoryRequest) (*QuerySlashHistoryResponse, error)
+	// DustAmount queries the yield accrued but not yet moved, below one unit of
+	// the yield denom.
+	DustAmount(context.Context, *QueryDustAmountRequest) (*QueryDustAmountResponse, error)
+	// ModuleBalances queries the balances of the td and verana_pool module
+	// accounts.
+	ModuleBalances(context.Context, *QueryModuleBalancesRequest) (*QueryModuleBalancesResponse, error)
+	// YieldState queries the current yield rate and the yield moved so far.
+	YieldState(context.Context, *QueryYieldStateRequest) (*QueryYieldStateResponse, error)
+	// ProjectedYield queries the yield the current trust deposit value earns
+	// over a duration at the current yield rate.
+	ProjectedYield(context.Context, *QueryProjectedYieldRequest) (*QueryProjectedYieldResponse, error)
+	// EffectiveAPR queries the annual yield rate actually paid, computed from
+	// the yield moved to the trust deposit module.
+	EffectiveAPR(context.Context, *QueryEffectiveAPRRequest) (*QueryEffectiveAPRResponse, error)
+	// Invariants runs the td module invariants and reports which are broken.
+	Invariants(context.Context, *QueryInvariantsRequest) (*QueryInvariantsResponse, error)
+	// FundingGap compares the yield the trust deposits require with what the
+	// verana_pool continuous fund streams.
+	FundingGap(context.Context, *QueryFundingGapRequest) (*QueryFundingGapResponse, error)
+	// YieldShortfall queries the yield verana_pool could not pay and still owes
+	// to the trust deposits.
+	YieldShortfall(context.Context, *QueryYieldShortfallRequest) (*QueryYieldShortfallResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) FeeSourcedFunding(ctx context.Context, req *QueryFeeSourcedFundingRequest) (*QueryFeeSourcedFundingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeSourcedFunding not implemented")
+}
+func (*UnimplementedQueryServer) TrustDeposit(ctx context.Context, req *QueryTrustDepositRequest) (*QueryTrustDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrustDeposit not implemented")
+}
+func (*UnimplementedQueryServer) PendingWithdrawals(ctx context.Context, req *QueryPendingWithdrawalsRequest) (*QueryPendingWithdrawalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingWithdrawals not implemented")
+}
+func (*UnimplementedQueryServer) SlashHistory(ctx context.Context, req *QuerySlashHistoryRequest) (*QuerySlashHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashHistory not implemented")
+}
+func (*UnimplementedQueryServer) DustAmount(ctx context.Context, req *QueryDustAmountRequest) (*QueryDustAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DustAmount not implemented")
+}
+func (*UnimplementedQueryServer) ModuleBalances(ctx context.Context, req *QueryModuleBalancesRequest) (*QueryModuleBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleBalances not implemented")
+}
+func (*UnimplementedQueryServer) YieldState(ctx context.Context, req *QueryYieldStateRequest) (*QueryYieldStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method YieldState not implemented")
+}
+func (*UnimplementedQueryServer) ProjectedYield(ctx context.Context, req *QueryProjectedYieldRequest) (*QueryProjectedYieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedYield not implemented")
+}
+func (*UnimplementedQueryServer) EffectiveAPR(ctx context.Context, req *QueryEffectiveAPRRequest) (*QueryEffectiveAPRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveAPR not implemented")
+}
+func (*UnimplementedQueryServer) Invariants(ctx context.Context, req *QueryInvariantsRequest) (*QueryInvariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invariants not implemented")
+}
+func (*UnimplementedQueryServer) FundingGap(ctx context.Context, req *QueryFundingGapRequest) (*QueryFundingGapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundingGap not implemented")
+}
+func (*UnimplementedQueryServer) YieldShortfall(ctx context.Context, req *QueryYieldShortfallRequest) (*QueryYieldShortfallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method YieldShortfall not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/veranatest.td.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeSourcedFunding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSourcedFundingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeSourcedFunding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/veranatest.td.v1.Query/FeeSourcedFunding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeSourcedFunding(ctx, req.(*QueryFeeSourcedFundingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TrustDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTrustDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TrustDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/veranatest.td.v1.Query/TrustDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TrustDeposit(ctx, req.(*QueryTrustDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingWithdrawalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingWithdrawals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/veranatest.td.v1.Query/PendingWithdrawals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingWithdrawals(ctx, req.(*QueryPendingWithdrawalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/veranatest.td.v1.Query/SlashHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashHistory(ctx, req.(*QuerySlashHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DustAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDustAmountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DustAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/veranatest.td.v1.Query/DustAmount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DustAmount(ctx, req.(*QueryDustAmountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/veranatest.td.v1.Query/ModuleBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleBalances(ctx, req.(*QueryModuleBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_YieldState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryYieldStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).YieldState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/veranatest.td.v1.Query/YieldState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).YieldState(ctx, req.(*QueryYieldStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectedYield_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedYieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedYield(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/veranatest.td.v1.Query/ProjectedYield",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedYield(ctx, req.(*QueryProjectedYieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EffectiveAPR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectiveAPRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveAPR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/veranatest.td.v1.Query/EffectiveAPR",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveAPR(ctx, req.(*QueryEffectiveAPRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Invariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInvariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Invariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/veranatest.td.v1.Query/Invariants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Invariants(ctx, req.(*QueryInvariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FundingGap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundingGapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FundingGap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/veranatest.td.v1.Query/FundingGap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FundingGap(ctx, req.(*QueryFundingGapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_YieldShortfall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryYieldShortfallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).YieldShortfall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/veranatest.td.v1.Query/YieldShortfall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).YieldShortfall(ctx, req.(*QueryYieldShortfallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "veranatest.td.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "FeeSourcedFunding",
+			Handler:    _Query_FeeSourcedFunding_Handler,
+		},
+		{
+			MethodName: "TrustDeposit",
+			Handler:    _Query_TrustDeposit_Handler,
+		},
+		{
+			MethodName: "PendingWithdrawals",
+			Handler:    _Query_PendingWithdrawals_Handler,
+		},
+		{
+			MethodName: "SlashHistory",
+			Handler:    _Query_SlashHistory_Handler,
+		},
+		{
+			MethodName: "DustAmount",
+			Handler:    _Query_DustAmount_Handler,
+		},
+		{
+			MethodName: "ModuleBalances",
+			Handler:    _Query_ModuleBalances_Handler,
+		},
+		{
+			MethodName: "YieldState",
+			Handler:    _Query_YieldState_Handler,
+		},
+		{
+			MethodName: "ProjectedYield",
+			Handler:    _Query_ProjectedYield_Handler,
+		},
+		{
+			MethodName: "EffectiveAPR",
+			Handler:    _Query_EffectiveAPR_Handler,
+		},
+		{
+			MethodName: "Invariants",
+			Handler:    _Query_Invariants_Handler,
+		},
+		{
+			MethodName: "FundingGap",
+			Handler:    _Query_FundingGap_Handler,
+		},
+		{
+			MethodName: "YieldShortfall",
+			Handler:    _Query_YieldShortfall_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "veranatest/td/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSourcedFundingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSourcedFundingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSourcedFundingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSourcedFundingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSourcedFundingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSourcedFundingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTrustDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTrustDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTrustDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTrustDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTrustDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTrustDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ShareValue.Size()
+		i -= size
+		if _, err := m.ShareValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Value != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.TrustDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingWithdrawalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingWithdrawalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingWithdrawalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingWithdrawalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingWithdrawalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingWithdrawalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingWithdrawals) > 0 {
+		for iNdEx := len(m.PendingWithdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingWithdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SlashRecords) > 0 {
+		for iNdEx := len(m.SlashRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDustAmountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDustAmountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDustAmountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDustAmountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDustAmountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDustAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Dust.Size()
+		i -= size
+		if _, err := m.Dust.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VeranaPool) > 0 {
+		for iNdEx := len(m.VeranaPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VeranaPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Td) > 0 {
+		for iNdEx := len(m.Td) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Td[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryYieldStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryYieldStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryYieldStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryYieldStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryYieldStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryYieldStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.YieldCircuit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.TotalTransferred != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalTransferred))
+		i--
+		dAtA[i] = 0x30
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastTransferTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastTransferTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x2a
+	if m.LastTransferHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastTransferHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastTransferAmount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastTransferAmount))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.YieldPerBlock.Size()
+		i -= size
+		if _, err := m.YieldPerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.YieldPerSecond.Size()
+		i -= size
+		if _, err := m.YieldPerSecond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedYieldRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedYieldRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedYieldRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedYieldResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedYieldResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedYieldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Yield.Size()
+		i -= size
+		if _, err := m.Yield.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveAPRRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveAPRRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveAPRRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveAPRResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveAPRResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveAPRResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Apr.Size()
+		i -= size
+		if _, err := m.Apr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInvariantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInvariantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Invariants) > 0 {
+		for iNdEx := len(m.Invariants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Invariants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InvariantResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundingGapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundingGapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingGapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundingGapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundingGapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingGapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Gap.Size()
+		i -= size
+		if _, err := m.Gap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.CurrentPercentage.Size()
+		i -= size
+		if _, err := m.CurrentPercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RequiredPercentage.Size()
+		i -= size
+		if _, err := m.RequiredPercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.CommunityPool) > 0 {
+		for iNdEx := len(m.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.CommunityPoolInflow.Size()
+		i -= size
+		if _, err := m.CommunityPoolInflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.RequiredYield.Size()
+		i -= size
+		if _, err := m.RequiredYield.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryYieldShortfallRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryYieldShortfallRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryYieldShortfallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryYieldShortfallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryYieldShortfallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryYieldShortfallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.YieldShortfall.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeeSourcedFundingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeSourcedFundingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTrustDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTrustDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TrustDeposit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Value != 0 {
+		n += 1 + sovQuery(uint64(m.Value))
+	}
+	l = m.ShareValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingWithdrawalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingWithdrawalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingWithdrawals) > 0 {
+		for _, e := range m.PendingWithdrawals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SlashRecords) > 0 {
+		for _, e := range m.SlashRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDustAmountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDustAmountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Dust.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryModuleBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModuleBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Td) > 0 {
+		for _, e := range m.Td {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.VeranaPool) > 0 {
+		for _, e := range m.VeranaPool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryYieldStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryYieldStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.YieldPerSecond.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.YieldPerBlock.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LastTransferAmount != 0 {
+		n += 1 + sovQuery(uint64(m.LastTransferAmount))
+	}
+	if m.LastTransferHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastTransferHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastTransferTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.TotalTransferred != 0 {
+		n += 1 + sovQuery(uint64(m.TotalTransferred))
+	}
+	l = m.YieldCircuit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProjectedYieldRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProjectedYieldResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Yield.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEffectiveAPRRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEffectiveAPRResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Apr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInvariantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInvariantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Invariants) > 0 {
+		for _, e := range m.Invariants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InvariantResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Broken {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFundingGapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFundingGapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RequiredYield.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CommunityPoolInflow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.CommunityPool) > 0 {
+		for _, e := range m.CommunityPool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.RequiredPercentage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentPercentage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Gap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryYieldShortfallRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryYieldShortfallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.YieldShortfall.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeSourcedFundingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeSourcedFundingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeSourcedFundingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeSourcedFundingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeSourcedFundingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeSourcedFundingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTrustDepositRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTrustDepositRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTrustDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTrustDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTrustDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTrustDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrustDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingWithdrawalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingWithdrawalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingWithdrawalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingWithdrawalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingWithdrawalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingWithdrawalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingWithdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingWithdrawals = append(m.PendingWithdrawals, PendingWithdrawal{})
+			if err := m.PendingWithdrawals[len(m.PendingWithdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRecords = append(m.SlashRecords, SlashRecord{})
+			if err := m.SlashRecords[len(m.SlashRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDustAmountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDustAmountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDustAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDustAmountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDustAmountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDustAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Dust.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Td", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Td = append(m.Td, types.Coin{})
+			if err := m.Td[len(m.Td)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeranaPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeranaPool = append(m.VeranaPool, types.Coin{})
+			if err := m.VeranaPool[len(m.VeranaPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryYieldStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryYieldStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryYieldStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryYieldStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryYieldStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryYieldStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldPerSecond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.YieldPerSecond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldPerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.YieldPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransferAmount", wireType)
+			}
+			m.LastTransferAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTransferAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransferHeight", wireType)
+			}
+			m.LastTransferHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTransferHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransferTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastTransferTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTransferred", wireType)
+			}
+			m.TotalTransferred = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalTransferred |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldCircuit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.YieldCircuit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedYieldRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedYieldRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedYieldRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedYieldResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedYieldResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedYieldResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Yield", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Yield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveAPRRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveAPRRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveAPRRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveAPRResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveAPRResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveAPRResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Apr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInvariantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvariantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvariantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInvariantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvariantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvariantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invariants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Invariants = append(m.Invariants, InvariantResult{})
+			if err := m.Invariants[len(m.Invariants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InvariantResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvariantResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvariantResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broken = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundingGapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingGapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingGapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFundingGapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingGapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingGapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredYield", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequiredYield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolInflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolInflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPool = append(m.CommunityPool, types.Coin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequiredPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Gap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryYieldShortfallRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -343,10 +5830,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryYieldShortfallRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryYieldShortfallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -370,7 +5857,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryYieldShortfallResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -393,15 +5880,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryYieldShortfallResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryYieldShortfallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldShortfall", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -428,7 +5915,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.YieldShortfall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
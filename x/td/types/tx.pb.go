@@ -5,25 +5,32 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -124,18 +131,124 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUpdateParamsPartial is the Msg/UpdateParamsPartial request type.
+type MsgUpdateParamsPartial struct {
+	// authority is the address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params holds the new values of the parameters in update_mask. Other
+	// fields are ignored.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// update_mask is the field mask of the parameters to update, by their proto
+	// field name, e.g. "trust_deposit_yield_rate".
+	UpdateMask []string `protobuf:"bytes,3,rep,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (m *MsgUpdateParamsPartial) Reset()         { *m = MsgUpdateParamsPartial{} }
+func (m *MsgUpdateParamsPartial) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsPartial) ProtoMessage()    {}
+func (*MsgUpdateParamsPartial) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ad71b4b0e957e5, []int{2}
+}
+func (m *MsgUpdateParamsPartial) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsPartial) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsPartial.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsPartial) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsPartial.Merge(m, src)
+}
+func (m *MsgUpdateParamsPartial) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsPartial) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsPartial.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsPartial proto.InternalMessageInfo
+
+func (m *MsgUpdateParamsPartial) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParamsPartial) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *MsgUpdateParamsPartial) GetUpdateMask() []string {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+// MsgUpdateParamsPartialResponse defines the response structure for executing
+// a MsgUpdateParamsPartial message.
+type MsgUpdateParamsPartialResponse struct {
+}
+
+func (m *MsgUpdateParamsPartialResponse) Reset()         { *m = MsgUpdateParamsPartialResponse{} }
+func (m *MsgUpdateParamsPartialResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsPartialResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsPartialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ad71b4b0e957e5, []int{3}
+}
+func (m *MsgUpdateParamsPartialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsPartialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsPartialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsPartialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsPartialResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsPartialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsPartialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsPartialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsPartialResponse proto.InternalMessageInfo
+
 // MsgFundModule defines the MsgFundModule message.
 type MsgFundModule struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Amount  int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Module  string `protobuf:"bytes,3,opt,name=module,proto3" json:"module,omitempty"`
+	Creator string                                   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// module is the name of the module to fund, one of the fundable_modules
+	// param.
+	Module string `protobuf:"bytes,3,opt,name=module,proto3" json:"module,omitempty"`
 }
 
 func (m *MsgFundModule) Reset()         { *m = MsgFundModule{} }
 func (m *MsgFundModule) String() string { return proto.CompactTextString(m) }
 func (*MsgFundModule) ProtoMessage()    {}
 func (*MsgFundModule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3ad71b4b0e957e5, []int{2}
+	return fileDescriptor_c3ad71b4b0e957e5, []int{4}
 }
 func (m *MsgFundModule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -171,11 +284,11 @@ func (m *MsgFundModule) GetCreator() string {
 	return ""
 }
 
-func (m *MsgFundModule) GetAmount() int64 {
+func (m *MsgFundModule) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.Amount
 	}
-	return 0
+	return nil
 }
 
 func (m *MsgFundModule) GetModule() string {
@@ -193,7 +306,7 @@ func (m *MsgFundModuleResponse) Reset()         { *m = MsgFundModuleResponse{} }
 func (m *MsgFundModuleResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgFundModuleResponse) ProtoMessage()    {}
 func (*MsgFundModuleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3ad71b4b0e957e5, []int{3}
+	return fileDescriptor_c3ad71b4b0e957e5, []int{5}
 }
 func (m *MsgFundModuleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -222,399 +335,2775 @@ func (m *MsgFundModuleResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgFundModuleResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "veranatest.td.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "veranatest.td.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgFundModule)(nil), "veranatest.td.v1.MsgFundModule")
-	proto.RegisterType((*MsgFundModuleResponse)(nil), "veranatest.td.v1.MsgFundModuleResponse")
+// MsgReclaimTrustDeposit defines the MsgReclaimTrustDeposit message.
+type MsgReclaimTrustDeposit struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// amount is the principal to withdraw, in uvna.
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func init() { proto.RegisterFile("veranatest/td/v1/tx.proto", fileDescriptor_c3ad71b4b0e957e5) }
-
-var fileDescriptor_c3ad71b4b0e957e5 = []byte{
-	// 423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0x4b, 0x2d, 0x4a,
-	0xcc, 0x4b, 0x2c, 0x49, 0x2d, 0x2e, 0xd1, 0x2f, 0x49, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x40, 0x48, 0xe9, 0x95, 0xa4, 0xe8, 0x95, 0x19, 0x4a,
-	0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x22, 0x29, 0xf1, 0xe4, 0xfc, 0xe2,
-	0xdc, 0xfc, 0x62, 0xfd, 0xdc, 0xe2, 0x74, 0x90, 0xe6, 0xdc, 0xe2, 0x74, 0xa8, 0x84, 0x24, 0x44,
-	0x22, 0x1e, 0xcc, 0xd3, 0x87, 0x70, 0xa0, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x10, 0x71, 0x10,
-	0x0b, 0x2a, 0x2a, 0x8b, 0xe1, 0x92, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0x26, 0xa5, 0x5d, 0x8c,
-	0x5c, 0xfc, 0xbe, 0xc5, 0xe9, 0xa1, 0x05, 0x29, 0x89, 0x25, 0xa9, 0x01, 0x60, 0x19, 0x21, 0x33,
-	0x2e, 0xce, 0xc4, 0xd2, 0x92, 0x8c, 0xfc, 0xa2, 0xcc, 0x92, 0x4a, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x4e, 0x27, 0x89, 0x4b, 0x5b, 0x74, 0x45, 0xa0, 0xb6, 0x39, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17,
-	0x07, 0x97, 0x14, 0x65, 0xe6, 0xa5, 0x07, 0x21, 0x94, 0x0a, 0x59, 0x73, 0xb1, 0x41, 0xcc, 0x96,
-	0x60, 0x52, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd0, 0x43, 0xf7, 0xaa, 0x1e, 0xc4, 0x06, 0x27, 0xce,
-	0x13, 0xf7, 0xe4, 0x19, 0x56, 0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x04, 0xd5, 0x62, 0x65, 0xd4, 0xf4,
-	0x7c, 0x83, 0x16, 0xc2, 0xb0, 0xae, 0xe7, 0x1b, 0xb4, 0xe4, 0x91, 0x9c, 0x5e, 0x01, 0x72, 0x3c,
-	0x9a, 0x43, 0x95, 0x24, 0xb9, 0xc4, 0xd1, 0x84, 0x82, 0x52, 0x8b, 0x0b, 0xf2, 0xf3, 0x8a, 0x53,
-	0x95, 0x1a, 0x19, 0xb9, 0x78, 0x7d, 0x8b, 0xd3, 0xdd, 0x4a, 0xf3, 0x52, 0x7c, 0xf3, 0x53, 0x4a,
-	0x73, 0x52, 0x85, 0x8c, 0xb8, 0xd8, 0x93, 0x8b, 0x52, 0x13, 0x4b, 0xf2, 0x8b, 0x08, 0xfa, 0x09,
-	0xa6, 0x50, 0x48, 0x8c, 0x8b, 0x2d, 0x31, 0x37, 0xbf, 0x34, 0xaf, 0x04, 0xec, 0x23, 0xe6, 0x20,
-	0x28, 0x0f, 0x24, 0x9e, 0x0b, 0x36, 0x55, 0x82, 0x19, 0x64, 0x54, 0x10, 0x94, 0x67, 0xc5, 0x03,
-	0xf2, 0x04, 0x4c, 0xb7, 0x92, 0x38, 0x97, 0x28, 0x8a, 0x13, 0x60, 0x8e, 0x33, 0x3a, 0xc4, 0xc8,
-	0xc5, 0xec, 0x5b, 0x9c, 0x2e, 0x14, 0xc3, 0xc5, 0x83, 0x12, 0xf0, 0x8a, 0x98, 0x01, 0x86, 0xe6,
-	0x3f, 0x29, 0x4d, 0x82, 0x4a, 0x60, 0xb6, 0x08, 0x85, 0x71, 0x71, 0x21, 0x79, 0x5f, 0x1e, 0xab,
-	0x46, 0x84, 0x02, 0x29, 0x75, 0x02, 0x0a, 0x60, 0xe6, 0x4a, 0xb1, 0x36, 0x80, 0x22, 0xce, 0x49,
-	0xff, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58,
-	0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x44, 0xd1, 0xe3, 0xad, 0xa4,
-	0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x9c, 0xe2, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x69,
-	0x0c, 0x79, 0x31, 0x1c, 0x03, 0x00, 0x00,
+func (m *MsgReclaimTrustDeposit) Reset()         { *m = MsgReclaimTrustDeposit{} }
+func (m *MsgReclaimTrustDeposit) String() string { return proto.CompactTextString(m) }
+func (*MsgReclaimTrustDeposit) ProtoMessage()    {}
+func (*MsgReclaimTrustDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ad71b4b0e957e5, []int{6}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// FundModule defines the FundModule RPC.
-	FundModule(ctx context.Context, in *MsgFundModule, opts ...grpc.CallOption) (*MsgFundModuleResponse, error)
+func (m *MsgReclaimTrustDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgReclaimTrustDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReclaimTrustDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgReclaimTrustDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReclaimTrustDeposit.Merge(m, src)
+}
+func (m *MsgReclaimTrustDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReclaimTrustDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReclaimTrustDeposit.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/veranatest.td.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgReclaimTrustDeposit proto.InternalMessageInfo
+
+func (m *MsgReclaimTrustDeposit) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) FundModule(ctx context.Context, in *MsgFundModule, opts ...grpc.CallOption) (*MsgFundModuleResponse, error) {
-	out := new(MsgFundModuleResponse)
-	err := c.cc.Invoke(ctx, "/veranatest.td.v1.Msg/FundModule", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgReclaimTrustDeposit) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// FundModule defines the FundModule RPC.
-	FundModule(context.Context, *MsgFundModule) (*MsgFundModuleResponse, error)
+// MsgReclaimTrustDepositResponse defines the MsgReclaimTrustDepositResponse message.
+type MsgReclaimTrustDepositResponse struct {
+	WithdrawalId   uint64    `protobuf:"varint,1,opt,name=withdrawal_id,json=withdrawalId,proto3" json:"withdrawal_id,omitempty"`
+	CompletionTime time.Time `protobuf:"bytes,2,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgReclaimTrustDepositResponse) Reset()         { *m = MsgReclaimTrustDepositResponse{} }
+func (m *MsgReclaimTrustDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReclaimTrustDepositResponse) ProtoMessage()    {}
+func (*MsgReclaimTrustDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ad71b4b0e957e5, []int{7}
 }
-
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgReclaimTrustDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) FundModule(ctx context.Context, req *MsgFundModule) (*MsgFundModuleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FundModule not implemented")
+func (m *MsgReclaimTrustDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReclaimTrustDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgReclaimTrustDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReclaimTrustDepositResponse.Merge(m, src)
+}
+func (m *MsgReclaimTrustDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReclaimTrustDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReclaimTrustDepositResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/veranatest.td.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+var xxx_messageInfo_MsgReclaimTrustDepositResponse proto.InternalMessageInfo
+
+func (m *MsgReclaimTrustDepositResponse) GetWithdrawalId() uint64 {
+	if m != nil {
+		return m.WithdrawalId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_FundModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFundModule)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FundModule(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/veranatest.td.v1.Msg/FundModule",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FundModule(ctx, req.(*MsgFundModule))
+func (m *MsgReclaimTrustDepositResponse) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return time.Time{}
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "veranatest.td.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "FundModule",
-			Handler:    _Msg_FundModule_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "veranatest/td/v1/tx.proto",
+// MsgReclaimYield defines the MsgReclaimYield message.
+type MsgReclaimYield struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgReclaimYield) Reset()         { *m = MsgReclaimYield{} }
+func (m *MsgReclaimYield) String() string { return proto.CompactTextString(m) }
+func (*MsgReclaimYield) ProtoMessage()    {}
+func (*MsgReclaimYield) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ad71b4b0e957e5, []int{8}
 }
-
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReclaimYield) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgReclaimYield) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReclaimYield.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgReclaimYield) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReclaimYield.Merge(m, src)
+}
+func (m *MsgReclaimYield) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReclaimYield) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReclaimYield.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgReclaimYield proto.InternalMessageInfo
+
+func (m *MsgReclaimYield) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgReclaimYieldResponse defines the MsgReclaimYieldResponse message.
+type MsgReclaimYieldResponse struct {
+	// amount is the yield paid out, in uvna.
+	Amount uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgReclaimYieldResponse) Reset()         { *m = MsgReclaimYieldResponse{} }
+func (m *MsgReclaimYieldResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReclaimYieldResponse) ProtoMessage()    {}
+func (*MsgReclaimYieldResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ad71b4b0e957e5, []int{9}
+}
+func (m *MsgReclaimYieldResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReclaimYieldResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReclaimYieldResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReclaimYieldResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReclaimYieldResponse.Merge(m, src)
+}
+func (m *MsgReclaimYieldResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReclaimYieldResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReclaimYieldResponse.DiscardUnknown(m)
 }
 
-func (m *MsgFundModule) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgReclaimYieldResponse proto.InternalMessageInfo
+
+func (m *MsgReclaimYieldResponse) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgFundModule) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSlashTrustDeposit is the Msg/SlashTrustDeposit request type.
+type MsgSlashTrustDeposit struct {
+	// authority is the address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// account is the address of the trust deposit to slash.
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// fraction is the fraction of the trust deposit to slash, in (0, 1].
+	Fraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=fraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fraction"`
+	// burn burns the slashed amount instead of sending it to the community pool.
+	Burn   bool   `protobuf:"varint,4,opt,name=burn,proto3" json:"burn,omitempty"`
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *MsgFundModule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Module) > 0 {
-		i -= len(m.Module)
-		copy(dAtA[i:], m.Module)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Module)))
-		i--
-		dAtA[i] = 0x1a
+func (m *MsgSlashTrustDeposit) Reset()         { *m = MsgSlashTrustDeposit{} }
+func (m *MsgSlashTrustDeposit) String() string { return proto.CompactTextString(m) }
+func (*MsgSlashTrustDeposit) ProtoMessage()    {}
+func (*MsgSlashTrustDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ad71b4b0e957e5, []int{10}
+}
+func (m *MsgSlashTrustDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSlashTrustDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSlashTrustDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *MsgSlashTrustDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSlashTrustDeposit.Merge(m, src)
+}
+func (m *MsgSlashTrustDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSlashTrustDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSlashTrustDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSlashTrustDeposit proto.InternalMessageInfo
+
+func (m *MsgSlashTrustDeposit) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgSlashTrustDeposit) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgFundModuleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSlashTrustDeposit) GetBurn() bool {
+	if m != nil {
+		return m.Burn
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgFundModuleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSlashTrustDeposit) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *MsgFundModuleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSlashTrustDepositResponse defines the response structure for executing a
+// MsgSlashTrustDeposit message.
+type MsgSlashTrustDepositResponse struct {
+	// amount is the amount slashed, in uvna.
+	Amount uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgSlashTrustDepositResponse) Reset()         { *m = MsgSlashTrustDepositResponse{} }
+func (m *MsgSlashTrustDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSlashTrustDepositResponse) ProtoMessage()    {}
+func (*MsgSlashTrustDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ad71b4b0e957e5, []int{11}
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSlashTrustDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSlashTrustDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSlashTrustDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgSlashTrustDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSlashTrustDepositResponse.Merge(m, src)
+}
+func (m *MsgSlashTrustDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSlashTrustDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSlashTrustDepositResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSlashTrustDepositResponse proto.InternalMessageInfo
+
+func (m *MsgSlashTrustDepositResponse) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *MsgFundModule) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+// MsgUpdateContinuousFund is the Msg/UpdateContinuousFund request type.
+type MsgUpdateContinuousFund struct {
+	// authority is the address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// expiry is the time the continuous fund stops at, unset to never stop.
+	Expiry *time.Time `protobuf:"bytes,2,opt,name=expiry,proto3,stdtime" json:"expiry,omitempty"`
+}
+
+func (m *MsgUpdateContinuousFund) Reset()         { *m = MsgUpdateContinuousFund{} }
+func (m *MsgUpdateContinuousFund) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateContinuousFund) ProtoMessage()    {}
+func (*MsgUpdateContinuousFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ad71b4b0e957e5, []int{12}
+}
+func (m *MsgUpdateContinuousFund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateContinuousFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateContinuousFund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
+}
+func (m *MsgUpdateContinuousFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateContinuousFund.Merge(m, src)
+}
+func (m *MsgUpdateContinuousFund) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateContinuousFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateContinuousFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateContinuousFund proto.InternalMessageInfo
+
+func (m *MsgUpdateContinuousFund) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	l = len(m.Module)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgUpdateContinuousFund) GetExpiry() *time.Time {
+	if m != nil {
+		return m.Expiry
 	}
-	return n
+	return nil
 }
 
-func (m *MsgFundModuleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgUpdateContinuousFundResponse defines the response structure for executing
+// a MsgUpdateContinuousFund message.
+type MsgUpdateContinuousFundResponse struct {
+	// percentage is the percentage of the continuous fund, zero if it was
+	// removed.
+	Percentage cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=percentage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"percentage"`
+}
+
+func (m *MsgUpdateContinuousFundResponse) Reset()         { *m = MsgUpdateContinuousFundResponse{} }
+func (m *MsgUpdateContinuousFundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateContinuousFundResponse) ProtoMessage()    {}
+func (*MsgUpdateContinuousFundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ad71b4b0e957e5, []int{13}
+}
+func (m *MsgUpdateContinuousFundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateContinuousFundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateContinuousFundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgUpdateContinuousFundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateContinuousFundResponse.Merge(m, src)
+}
+func (m *MsgUpdateContinuousFundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateContinuousFundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateContinuousFundResponse.DiscardUnknown(m)
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+var xxx_messageInfo_MsgUpdateContinuousFundResponse proto.InternalMessageInfo
+
+// MsgResumeYield is the Msg/ResumeYield request type.
+type MsgResumeYield struct {
+	// authority is the address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgResumeYield) Reset()         { *m = MsgResumeYield{} }
+func (m *MsgResumeYield) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeYield) ProtoMessage()    {}
+func (*MsgResumeYield) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ad71b4b0e957e5, []int{14}
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+func (m *MsgResumeYield) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeYield) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeYield.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumeYield) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeYield.Merge(m, src)
+}
+func (m *MsgResumeYield) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeYield) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeYield.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeYield proto.InternalMessageInfo
+
+func (m *MsgResumeYield) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgResumeYieldResponse defines the response structure for executing a
+// MsgResumeYield message.
+type MsgResumeYieldResponse struct {
+}
+
+func (m *MsgResumeYieldResponse) Reset()         { *m = MsgResumeYieldResponse{} }
+func (m *MsgResumeYieldResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeYieldResponse) ProtoMessage()    {}
+func (*MsgResumeYieldResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ad71b4b0e957e5, []int{15}
+}
+func (m *MsgResumeYieldResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeYieldResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeYieldResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumeYieldResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeYieldResponse.Merge(m, src)
+}
+func (m *MsgResumeYieldResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeYieldResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeYieldResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeYieldResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "veranatest.td.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "veranatest.td.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateParamsPartial)(nil), "veranatest.td.v1.MsgUpdateParamsPartial")
+	proto.RegisterType((*MsgUpdateParamsPartialResponse)(nil), "veranatest.td.v1.MsgUpdateParamsPartialResponse")
+	proto.RegisterType((*MsgFundModule)(nil), "veranatest.td.v1.MsgFundModule")
+	proto.RegisterType((*MsgFundModuleResponse)(nil), "veranatest.td.v1.MsgFundModuleResponse")
+	proto.RegisterType((*MsgReclaimTrustDeposit)(nil), "veranatest.td.v1.MsgReclaimTrustDeposit")
+	proto.RegisterType((*MsgReclaimTrustDepositResponse)(nil), "veranatest.td.v1.MsgReclaimTrustDepositResponse")
+	proto.RegisterType((*MsgReclaimYield)(nil), "veranatest.td.v1.MsgReclaimYield")
+	proto.RegisterType((*MsgReclaimYieldResponse)(nil), "veranatest.td.v1.MsgReclaimYieldResponse")
+	proto.RegisterType((*MsgSlashTrustDeposit)(nil), "veranatest.td.v1.MsgSlashTrustDeposit")
+	proto.RegisterType((*MsgSlashTrustDepositResponse)(nil), "veranatest.td.v1.MsgSlashTrustDepositResponse")
+	proto.RegisterType((*MsgUpdateContinuousFund)(nil), "veranatest.td.v1.MsgUpdateContinuousFund")
+	proto.RegisterType((*MsgUpdateContinuousFundResponse)(nil), "veranatest.td.v1.MsgUpdateContinuousFundResponse")
+	proto.RegisterType((*MsgResumeYield)(nil), "veranatest.td.v1.MsgResumeYield")
+	proto.RegisterType((*MsgResumeYieldResponse)(nil), "veranatest.td.v1.MsgResumeYieldResponse")
+}
+
+func init() { proto.RegisterFile("veranatest/td/v1/tx.proto", fileDescriptor_c3ad71b4b0e957e5) }
+
+var fileDescriptor_c3ad71b4b0e957e5 = []byte{
+	// 1023 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0xdb, 0x54,
+	0x1c, 0xaf, 0xdb, 0x2c, 0x6b, 0xbf, 0xcd, 0x56, 0x66, 0xba, 0xd6, 0x35, 0xe0, 0x64, 0x1e, 0xda,
+	0xb2, 0x4a, 0xb3, 0x97, 0x20, 0x0d, 0x08, 0x07, 0x44, 0x3a, 0x4d, 0x42, 0x22, 0xd2, 0xe4, 0x0e,
+	0xa4, 0xa1, 0x49, 0xd1, 0x8b, 0xfd, 0xea, 0x58, 0x89, 0xfd, 0x8c, 0xdf, 0x73, 0xd7, 0x88, 0x0b,
+	0xe2, 0xc8, 0x69, 0x07, 0x4e, 0xfc, 0x05, 0x13, 0xa7, 0x1e, 0x38, 0x71, 0x46, 0x62, 0xc7, 0x89,
+	0x13, 0xe2, 0xb0, 0xa1, 0x16, 0xa9, 0xe2, 0xbf, 0x40, 0xb6, 0x5f, 0x9c, 0x1f, 0x76, 0x9b, 0x28,
+	0x17, 0x2e, 0x89, 0xdf, 0xfb, 0x7e, 0xbe, 0x3f, 0x3e, 0x1f, 0x7f, 0xbf, 0xef, 0x19, 0x76, 0x0e,
+	0x71, 0x80, 0x3c, 0xc4, 0x30, 0x65, 0x3a, 0xb3, 0xf4, 0xc3, 0x9a, 0xce, 0x8e, 0x34, 0x3f, 0x20,
+	0x8c, 0x88, 0x6f, 0x8d, 0x4c, 0x1a, 0xb3, 0xb4, 0xc3, 0x9a, 0x7c, 0x0d, 0xb9, 0x8e, 0x47, 0xf4,
+	0xf8, 0x37, 0x01, 0xc9, 0x8a, 0x49, 0xa8, 0x4b, 0xa8, 0xde, 0x41, 0x14, 0xeb, 0x87, 0xb5, 0x0e,
+	0x66, 0xa8, 0xa6, 0x9b, 0xc4, 0xf1, 0xb8, 0x7d, 0x9b, 0xdb, 0x5d, 0x6a, 0x47, 0xc1, 0x5d, 0x6a,
+	0x73, 0xc3, 0x4e, 0x62, 0x68, 0xc7, 0x2b, 0x3d, 0x59, 0x70, 0xd3, 0xa6, 0x4d, 0x6c, 0x92, 0xec,
+	0x47, 0x4f, 0x7c, 0xb7, 0x6c, 0x13, 0x62, 0xf7, 0xb1, 0x1e, 0xaf, 0x3a, 0xe1, 0x81, 0xce, 0x1c,
+	0x17, 0x53, 0x86, 0x5c, 0x9f, 0x03, 0xde, 0xcb, 0x50, 0xf1, 0x51, 0x80, 0x5c, 0x1e, 0x55, 0xfd,
+	0x55, 0x80, 0x8d, 0x16, 0xb5, 0xbf, 0xf4, 0x2d, 0xc4, 0xf0, 0xa3, 0xd8, 0x22, 0xde, 0x87, 0x35,
+	0x14, 0xb2, 0x2e, 0x09, 0x1c, 0x36, 0x90, 0x84, 0x8a, 0x50, 0x5d, 0x6b, 0x4a, 0x7f, 0xfc, 0x72,
+	0x77, 0x93, 0x97, 0xf3, 0x99, 0x65, 0x05, 0x98, 0xd2, 0x7d, 0x16, 0x38, 0x9e, 0x6d, 0x8c, 0xa0,
+	0xe2, 0x27, 0x50, 0x4c, 0x62, 0x4b, 0xcb, 0x15, 0xa1, 0xba, 0x5e, 0x97, 0xb4, 0x69, 0xad, 0xb4,
+	0x24, 0x43, 0x73, 0xed, 0xe5, 0xeb, 0xf2, 0xd2, 0x8b, 0xb3, 0xe3, 0x5d, 0xc1, 0xe0, 0x2e, 0x8d,
+	0xfa, 0xf7, 0x67, 0xc7, 0xbb, 0xa3, 0x60, 0x3f, 0x9c, 0x1d, 0xef, 0x96, 0xc7, 0x4a, 0x3f, 0x8a,
+	0x8a, 0x9f, 0x2a, 0x54, 0xdd, 0x81, 0xed, 0xa9, 0x2d, 0x03, 0x53, 0x9f, 0x78, 0x14, 0xab, 0xff,
+	0x0a, 0xb0, 0x35, 0x65, 0x7b, 0x84, 0x02, 0xe6, 0xa0, 0xfe, 0xff, 0x42, 0x4f, 0x2c, 0xc3, 0x7a,
+	0x18, 0xd7, 0xd2, 0x76, 0x11, 0xed, 0x49, 0x2b, 0x95, 0x95, 0xea, 0x9a, 0x01, 0xc9, 0x56, 0x0b,
+	0xd1, 0x5e, 0xe3, 0xe3, 0x2c, 0xff, 0x5b, 0x33, 0xf8, 0x73, 0x42, 0x6a, 0x05, 0x94, 0x7c, 0x4b,
+	0xaa, 0xc6, 0x3f, 0x02, 0x5c, 0x69, 0x51, 0xfb, 0x61, 0xe8, 0x59, 0x2d, 0x62, 0x85, 0x7d, 0x2c,
+	0xd6, 0xe1, 0xb2, 0x19, 0x60, 0xc4, 0x48, 0x30, 0x53, 0x82, 0x21, 0x50, 0x1c, 0x40, 0x11, 0xb9,
+	0x24, 0xf4, 0x98, 0xb4, 0x5c, 0x59, 0xa9, 0xae, 0xd7, 0x77, 0x34, 0x8e, 0x8f, 0xda, 0x5c, 0xe3,
+	0x6d, 0xae, 0xed, 0x11, 0xc7, 0x6b, 0x3e, 0x8c, 0x14, 0xf8, 0xf9, 0x4d, 0xb9, 0x6a, 0x3b, 0xac,
+	0x1b, 0x76, 0x34, 0x93, 0xb8, 0xbc, 0x9b, 0xf9, 0xdf, 0x5d, 0x6a, 0xf5, 0x74, 0x36, 0xf0, 0x31,
+	0x8d, 0x1d, 0xe8, 0x4f, 0x67, 0xc7, 0xbb, 0xa5, 0x3e, 0xb6, 0x91, 0x39, 0x68, 0x47, 0x83, 0x42,
+	0xb9, 0x7c, 0x49, 0x42, 0x71, 0x0b, 0x8a, 0x6e, 0x5c, 0xb8, 0xb4, 0x12, 0x55, 0x6b, 0xf0, 0x55,
+	0xa3, 0x14, 0xa9, 0x36, 0x2c, 0x50, 0xdd, 0x86, 0xeb, 0x13, 0x2c, 0x53, 0xfe, 0x41, 0xdc, 0x0c,
+	0x06, 0x36, 0xfb, 0xc8, 0x71, 0x1f, 0x07, 0x21, 0x65, 0x0f, 0xb0, 0x4f, 0xa8, 0xc3, 0x16, 0xd2,
+	0x61, 0x6b, 0x4c, 0x07, 0xa1, 0x5a, 0x18, 0x16, 0x39, 0x55, 0xcc, 0x8f, 0x02, 0x28, 0xf9, 0x49,
+	0x87, 0x65, 0x89, 0x37, 0xe1, 0xca, 0x33, 0x87, 0x75, 0xad, 0x00, 0x3d, 0x43, 0xfd, 0xb6, 0x63,
+	0xc5, 0x25, 0x14, 0x8c, 0xd2, 0x68, 0xf3, 0x73, 0x4b, 0x6c, 0xc1, 0x86, 0x49, 0x5c, 0xbf, 0x8f,
+	0x99, 0x43, 0xbc, 0x76, 0x34, 0xde, 0xbc, 0xff, 0x64, 0x2d, 0x99, 0x7d, 0x6d, 0x38, 0xfb, 0xda,
+	0xe3, 0xe1, 0xec, 0x37, 0x57, 0x23, 0xfd, 0x9f, 0xbf, 0x29, 0x0b, 0xc6, 0xd5, 0x91, 0x73, 0x64,
+	0x56, 0xf7, 0x61, 0x63, 0x54, 0xd5, 0x13, 0x07, 0xf7, 0xad, 0x45, 0x34, 0x98, 0xe2, 0x5a, 0x83,
+	0xed, 0xa9, 0xa0, 0x29, 0xc7, 0x91, 0x58, 0xc2, 0xb8, 0x58, 0xea, 0x8b, 0x65, 0xd8, 0x6c, 0x51,
+	0x7b, 0xbf, 0x8f, 0x68, 0x77, 0xe2, 0x8d, 0x2c, 0x3a, 0x9e, 0x75, 0xb8, 0x8c, 0x4c, 0x33, 0x7d,
+	0x2d, 0x17, 0xb2, 0xe0, 0x40, 0xf1, 0x53, 0x58, 0x3d, 0x08, 0x90, 0x19, 0x89, 0x93, 0x34, 0x56,
+	0xf3, 0x66, 0x24, 0xdc, 0x5f, 0xaf, 0xcb, 0xef, 0x24, 0x8e, 0xd4, 0xea, 0x69, 0x0e, 0xd1, 0x5d,
+	0xc4, 0xba, 0xda, 0x17, 0x71, 0x77, 0x3e, 0xc0, 0xa6, 0x91, 0x3a, 0x89, 0x22, 0x14, 0x3a, 0x61,
+	0xe0, 0x49, 0x85, 0x8a, 0x50, 0x5d, 0x35, 0xe2, 0xe7, 0x88, 0x71, 0x80, 0x11, 0x25, 0x9e, 0x74,
+	0x29, 0xe9, 0xd5, 0x64, 0xd5, 0xf8, 0x30, 0x3b, 0xe1, 0xef, 0xe7, 0x4c, 0x78, 0x46, 0x11, 0xf5,
+	0x3e, 0xbc, 0x9b, 0xb7, 0x3f, 0x53, 0xe2, 0xdf, 0x85, 0xb1, 0xf3, 0x71, 0x8f, 0x78, 0xcc, 0xf1,
+	0x42, 0x12, 0xd2, 0x68, 0x3e, 0x16, 0x56, 0xf9, 0x23, 0x28, 0xe2, 0x23, 0xdf, 0x09, 0x06, 0x73,
+	0x34, 0x61, 0x21, 0x6e, 0x40, 0x8e, 0x6f, 0x34, 0xb2, 0xf4, 0x6f, 0x9f, 0x7b, 0xc0, 0x4d, 0x56,
+	0xab, 0x1e, 0x40, 0xf9, 0x1c, 0x53, 0x2a, 0xc2, 0x1e, 0x80, 0x8f, 0x03, 0x13, 0x7b, 0x0c, 0xd9,
+	0x58, 0x12, 0xe6, 0x7f, 0x99, 0x63, 0x6e, 0xea, 0xb7, 0x70, 0x35, 0xee, 0x63, 0x1a, 0xba, 0x38,
+	0x99, 0x8d, 0x05, 0x75, 0x6a, 0xd4, 0xb2, 0x6c, 0x95, 0x1c, 0xb6, 0x63, 0xa9, 0x54, 0x09, 0xb6,
+	0x26, 0x77, 0x86, 0xdc, 0xea, 0xbf, 0x15, 0x61, 0xa5, 0x45, 0x6d, 0xf1, 0x29, 0x94, 0x26, 0x2e,
+	0xea, 0x1b, 0xd9, 0x1b, 0x68, 0xea, 0x22, 0x90, 0xef, 0xcc, 0x84, 0xa4, 0x0a, 0x7e, 0x03, 0x6f,
+	0xe7, 0x5d, 0x97, 0xd5, 0x99, 0x11, 0x38, 0x52, 0xbe, 0x37, 0x2f, 0x32, 0x4d, 0xf9, 0x15, 0xc0,
+	0xd8, 0x9d, 0x54, 0xce, 0xf5, 0x1f, 0x01, 0xe4, 0xdb, 0x33, 0x00, 0xe3, 0x54, 0xf2, 0x0e, 0xfb,
+	0x7c, 0x2a, 0x39, 0x48, 0xf9, 0xde, 0xbc, 0xc8, 0x34, 0xe5, 0x53, 0x28, 0x4d, 0x1c, 0xaa, 0x37,
+	0x2e, 0x8a, 0x10, 0x43, 0xe4, 0x3b, 0x33, 0x21, 0x69, 0xf4, 0x1e, 0x5c, 0xcb, 0x9e, 0x94, 0xb7,
+	0x72, 0xfd, 0x33, 0x38, 0x59, 0x9b, 0x0f, 0x97, 0x26, 0x63, 0xb0, 0x99, 0x7b, 0x66, 0x5c, 0xd4,
+	0x4b, 0x93, 0x50, 0xb9, 0x36, 0x37, 0x34, 0xcd, 0xfa, 0x04, 0xd6, 0xc7, 0x07, 0xaf, 0x72, 0x8e,
+	0x38, 0x29, 0x42, 0xae, 0xce, 0x42, 0x0c, 0x43, 0xcb, 0x97, 0xbe, 0x8b, 0x3e, 0x26, 0x9a, 0xfa,
+	0xcb, 0x13, 0x45, 0x78, 0x75, 0xa2, 0x08, 0x7f, 0x9f, 0x28, 0xc2, 0xf3, 0x53, 0x65, 0xe9, 0xd5,
+	0xa9, 0xb2, 0xf4, 0xe7, 0xa9, 0xb2, 0xf4, 0xf5, 0xf5, 0xe9, 0xd1, 0x8c, 0xbf, 0x4c, 0x3a, 0xc5,
+	0xf8, 0x50, 0xfb, 0xe0, 0xbf, 0x01, 0x00, 0xd5, 0xfc, 0x6b, 0x56, 0x0f, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateParamsPartial updates only the parameters listed in the update mask,
+	// leaving the others unchanged.
+	UpdateParamsPartial(ctx context.Context, in *MsgUpdateParamsPartial, opts ...grpc.CallOption) (*MsgUpdateParamsPartialResponse, error)
+	// FundModule sends coins from the creator to a fundable module account.
+	// Coins sent to the td module are a trust deposit of the creator.
+	FundModule(ctx context.Context, in *MsgFundModule, opts ...grpc.CallOption) (*MsgFundModuleResponse, error)
+	// ReclaimTrustDeposit withdraws principal from the trust deposit of the
+	// creator. It is paid out once the unbonding period has passed.
+	ReclaimTrustDeposit(ctx context.Context, in *MsgReclaimTrustDeposit, opts ...grpc.CallOption) (*MsgReclaimTrustDepositResponse, error)
+	// ReclaimYield pays out the yield earned by the trust deposit of the creator.
+	ReclaimYield(ctx context.Context, in *MsgReclaimYield, opts ...grpc.CallOption) (*MsgReclaimYieldResponse, error)
+	// SlashTrustDeposit slashes a fraction of the trust deposit of an account,
+	// including its pending withdrawals. The authority defaults to the council
+	// group policy.
+	SlashTrustDeposit(ctx context.Context, in *MsgSlashTrustDeposit, opts ...grpc.CallOption) (*MsgSlashTrustDepositResponse, error)
+	// UpdateContinuousFund creates or replaces the protocolpool continuous fund
+	// streaming into verana_pool, at the percentage the FundingGap query
+	// requires. The authority defaults to the council group policy.
+	UpdateContinuousFund(ctx context.Context, in *MsgUpdateContinuousFund, opts ...grpc.CallOption) (*MsgUpdateContinuousFundResponse, error)
+	// ResumeYield resumes the yield transfers and sweeps of verana_pool paused
+	// after yield_failure_limit consecutive failures. The authority defaults to
+	// the council group policy.
+	ResumeYield(ctx context.Context, in *MsgResumeYield, opts ...grpc.CallOption) (*MsgResumeYieldResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/veranatest.td.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParamsPartial(ctx context.Context, in *MsgUpdateParamsPartial, opts ...grpc.CallOption) (*MsgUpdateParamsPartialResponse, error) {
+	out := new(MsgUpdateParamsPartialResponse)
+	err := c.cc.Invoke(ctx, "/veranatest.td.v1.Msg/UpdateParamsPartial", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundModule(ctx context.Context, in *MsgFundModule, opts ...grpc.CallOption) (*MsgFundModuleResponse, error) {
+	out := new(MsgFundModuleResponse)
+	err := c.cc.Invoke(ctx, "/veranatest.td.v1.Msg/FundModule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReclaimTrustDeposit(ctx context.Context, in *MsgReclaimTrustDeposit, opts ...grpc.CallOption) (*MsgReclaimTrustDepositResponse, error) {
+	out := new(MsgReclaimTrustDepositResponse)
+	err := c.cc.Invoke(ctx, "/veranatest.td.v1.Msg/ReclaimTrustDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReclaimYield(ctx context.Context, in *MsgReclaimYield, opts ...grpc.CallOption) (*MsgReclaimYieldResponse, error) {
+	out := new(MsgReclaimYieldResponse)
+	err := c.cc.Invoke(ctx, "/veranatest.td.v1.Msg/ReclaimYield", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SlashTrustDeposit(ctx context.Context, in *MsgSlashTrustDeposit, opts ...grpc.CallOption) (*MsgSlashTrustDepositResponse, error) {
+	out := new(MsgSlashTrustDepositResponse)
+	err := c.cc.Invoke(ctx, "/veranatest.td.v1.Msg/SlashTrustDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateContinuousFund(ctx context.Context, in *MsgUpdateContinuousFund, opts ...grpc.CallOption) (*MsgUpdateContinuousFundResponse, error) {
+	out := new(MsgUpdateContinuousFundResponse)
+	err := c.cc.Invoke(ctx, "/veranatest.td.v1.Msg/UpdateContinuousFund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeYield(ctx context.Context, in *MsgResumeYield, opts ...grpc.CallOption) (*MsgResumeYieldResponse, error) {
+	out := new(MsgResumeYieldResponse)
+	err := c.cc.Invoke(ctx, "/veranatest.td.v1.Msg/ResumeYield", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateParamsPartial updates only the parameters listed in the update mask,
+	// leaving the others unchanged.
+	UpdateParamsPartial(context.Context, *MsgUpdateParamsPartial) (*MsgUpdateParamsPartialResponse, error)
+	// FundModule sends coins from the creator to a fundable module account.
+	// Coins sent to the td module are a trust deposit of the creator.
+	FundModule(context.Context, *MsgFundModule) (*MsgFundModuleResponse, error)
+	// ReclaimTrustDeposit withdraws principal from the trust deposit of the
+	// creator. It is paid out once the unbonding period has passed.
+	ReclaimTrustDeposit(context.Context, *MsgReclaimTrustDeposit) (*MsgReclaimTrustDepositResponse, error)
+	// ReclaimYield pays out the yield earned by the trust deposit of the creator.
+	ReclaimYield(context.Context, *MsgReclaimYield) (*MsgReclaimYieldResponse, error)
+	// SlashTrustDeposit slashes a fraction of the trust deposit of an account,
+	// including its pending withdrawals. The authority defaults to the council
+	// group policy.
+	SlashTrustDeposit(context.Context, *MsgSlashTrustDeposit) (*MsgSlashTrustDepositResponse, error)
+	// UpdateContinuousFund creates or replaces the protocolpool continuous fund
+	// streaming into verana_pool, at the percentage the FundingGap query
+	// requires. The authority defaults to the council group policy.
+	UpdateContinuousFund(context.Context, *MsgUpdateContinuousFund) (*MsgUpdateContinuousFundResponse, error)
+	// ResumeYield resumes the yield transfers and sweeps of verana_pool paused
+	// after yield_failure_limit consecutive failures. The authority defaults to
+	// the council group policy.
+	ResumeYield(context.Context, *MsgResumeYield) (*MsgResumeYieldResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParamsPartial(ctx context.Context, req *MsgUpdateParamsPartial) (*MsgUpdateParamsPartialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParamsPartial not implemented")
+}
+func (*UnimplementedMsgServer) FundModule(ctx context.Context, req *MsgFundModule) (*MsgFundModuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundModule not implemented")
+}
+func (*UnimplementedMsgServer) ReclaimTrustDeposit(ctx context.Context, req *MsgReclaimTrustDeposit) (*MsgReclaimTrustDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReclaimTrustDeposit not implemented")
+}
+func (*UnimplementedMsgServer) ReclaimYield(ctx context.Context, req *MsgReclaimYield) (*MsgReclaimYieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReclaimYield not implemented")
+}
+func (*UnimplementedMsgServer) SlashTrustDeposit(ctx context.Context, req *MsgSlashTrustDeposit) (*MsgSlashTrustDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashTrustDeposit not implemented")
+}
+func (*UnimplementedMsgServer) UpdateContinuousFund(ctx context.Context, req *MsgUpdateContinuousFund) (*MsgUpdateContinuousFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateContinuousFund not implemented")
+}
+func (*UnimplementedMsgServer) ResumeYield(ctx context.Context, req *MsgResumeYield) (*MsgResumeYieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeYield not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/veranatest.td.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParamsPartial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParamsPartial)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParamsPartial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/veranatest.td.v1.Msg/UpdateParamsPartial",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParamsPartial(ctx, req.(*MsgUpdateParamsPartial))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundModule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/veranatest.td.v1.Msg/FundModule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundModule(ctx, req.(*MsgFundModule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReclaimTrustDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReclaimTrustDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReclaimTrustDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/veranatest.td.v1.Msg/ReclaimTrustDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReclaimTrustDeposit(ctx, req.(*MsgReclaimTrustDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReclaimYield_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReclaimYield)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReclaimYield(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/veranatest.td.v1.Msg/ReclaimYield",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReclaimYield(ctx, req.(*MsgReclaimYield))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SlashTrustDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSlashTrustDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SlashTrustDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/veranatest.td.v1.Msg/SlashTrustDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SlashTrustDeposit(ctx, req.(*MsgSlashTrustDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateContinuousFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateContinuousFund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateContinuousFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/veranatest.td.v1.Msg/UpdateContinuousFund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateContinuousFund(ctx, req.(*MsgUpdateContinuousFund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeYield_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeYield)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeYield(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/veranatest.td.v1.Msg/ResumeYield",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeYield(ctx, req.(*MsgResumeYield))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "veranatest.td.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "UpdateParamsPartial",
+			Handler:    _Msg_UpdateParamsPartial_Handler,
+		},
+		{
+			MethodName: "FundModule",
+			Handler:    _Msg_FundModule_Handler,
+		},
+		{
+			MethodName: "ReclaimTrustDeposit",
+			Handler:    _Msg_ReclaimTrustDeposit_Handler,
+		},
+		{
+			MethodName: "ReclaimYield",
+			Handler:    _Msg_ReclaimYield_Handler,
+		},
+		{
+			MethodName: "SlashTrustDeposit",
+			Handler:    _Msg_SlashTrustDeposit_Handler,
+		},
+		{
+			MethodName: "UpdateContinuousFund",
+			Handler:    _Msg_UpdateContinuousFund_Handler,
+		},
+		{
+			MethodName: "ResumeYield",
+			Handler:    _Msg_ResumeYield_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "veranatest/td/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsPartial) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsPartial) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsPartial) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdateMask) > 0 {
+		for iNdEx := len(m.UpdateMask) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UpdateMask[iNdEx])
+			copy(dAtA[i:], m.UpdateMask[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.UpdateMask[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsPartialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsPartialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsPartialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundModule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundModule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundModule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundModuleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundModuleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundModuleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReclaimTrustDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReclaimTrustDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReclaimTrustDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReclaimTrustDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReclaimTrustDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReclaimTrustDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.WithdrawalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WithdrawalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReclaimYield) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReclaimYield) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReclaimYield) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReclaimYieldResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReclaimYieldResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReclaimYieldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSlashTrustDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSlashTrustDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSlashTrustDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Burn {
+		i--
+		if m.Burn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSlashTrustDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSlashTrustDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSlashTrustDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateContinuousFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateContinuousFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiry != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateContinuousFundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateContinuousFundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateContinuousFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Percentage.Size()
+		i -= size
+		if _, err := m.Percentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeYield) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeYield) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeYield) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeYieldResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeYieldResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeYieldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParamsPartial) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.UpdateMask) > 0 {
+		for _, s := range m.UpdateMask {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateParamsPartialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundModule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFundModuleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReclaimTrustDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgReclaimTrustDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WithdrawalId != 0 {
+		n += 1 + sovTx(uint64(m.WithdrawalId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgReclaimYield) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReclaimYieldResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgSlashTrustDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Burn {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSlashTrustDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgUpdateContinuousFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Expiry != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateContinuousFundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Percentage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgResumeYield) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResumeYieldResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsPartial) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsPartial: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsPartial: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMask", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateMask = append(m.UpdateMask, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsPartialResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsPartialResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsPartialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundModule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundModule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundModule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundModuleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundModuleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundModuleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReclaimTrustDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReclaimTrustDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReclaimTrustDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReclaimTrustDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReclaimTrustDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReclaimTrustDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalId", wireType)
+			}
+			m.WithdrawalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReclaimYield) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReclaimYield: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReclaimYield: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReclaimYieldResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReclaimYieldResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReclaimYieldResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSlashTrustDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSlashTrustDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSlashTrustDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -642,13 +3131,35 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Burn = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -658,24 +3169,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -698,7 +3208,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSlashTrustDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -721,12 +3231,31 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSlashTrustDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSlashTrustDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -748,7 +3277,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundModule) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateContinuousFund) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -771,15 +3300,15 @@ func (m *MsgFundModule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundModule: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateContinuousFund: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundModule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateContinuousFund: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -807,13 +3336,13 @@ func (m *MsgFundModule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
 			}
-			m.Amount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -823,14 +3352,81 @@ func (m *MsgFundModule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateContinuousFundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateContinuousFundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateContinuousFundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -858,7 +3454,9 @@ func (m *MsgFundModule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Module = string(dAtA[iNdEx:postIndex])
+			if err := m.Percentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -881,7 +3479,7 @@ func (m *MsgFundModule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundModuleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeYield) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -904,10 +3502,92 @@ func (m *MsgFundModuleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundModuleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeYield: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundModuleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumeYield: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumeYieldResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumeYieldResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumeYieldResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
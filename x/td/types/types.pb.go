@@ -7,19 +7,25 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -64,30 +70,625 @@ func (m *DustAmount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DustAmount proto.InternalMessageInfo
 
+// FeeSourcedFunding is the total amount of transaction fees moved to the trust
+// deposit module by the fee share post handler.
+type FeeSourcedFunding struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *FeeSourcedFunding) Reset()         { *m = FeeSourcedFunding{} }
+func (m *FeeSourcedFunding) String() string { return proto.CompactTextString(m) }
+func (*FeeSourcedFunding) ProtoMessage()    {}
+func (*FeeSourcedFunding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_904b969170e2d3ca, []int{1}
+}
+func (m *FeeSourcedFunding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSourcedFunding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSourcedFunding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSourcedFunding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSourcedFunding.Merge(m, src)
+}
+func (m *FeeSourcedFunding) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSourcedFunding) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSourcedFunding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSourcedFunding proto.InternalMessageInfo
+
+func (m *FeeSourcedFunding) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// YieldDistribution records the yield moved from verana_pool to the trust
+// deposit module.
+type YieldDistribution struct {
+	// total_transferred is the yield moved since genesis, in the yield denom.
+	TotalTransferred   uint64    `protobuf:"varint,1,opt,name=total_transferred,json=totalTransferred,proto3" json:"total_transferred,omitempty"`
+	LastTransferAmount uint64    `protobuf:"varint,2,opt,name=last_transfer_amount,json=lastTransferAmount,proto3" json:"last_transfer_amount,omitempty"`
+	LastTransferHeight int64     `protobuf:"varint,3,opt,name=last_transfer_height,json=lastTransferHeight,proto3" json:"last_transfer_height,omitempty"`
+	LastTransferTime   time.Time `protobuf:"bytes,4,opt,name=last_transfer_time,json=lastTransferTime,proto3,stdtime" json:"last_transfer_time"`
+	// value_time is the trust deposit value integrated over the block time yield
+	// was accrued for, in the yield denom times seconds.
+	ValueTime cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=value_time,json=valueTime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"value_time"`
+}
+
+func (m *YieldDistribution) Reset()         { *m = YieldDistribution{} }
+func (m *YieldDistribution) String() string { return proto.CompactTextString(m) }
+func (*YieldDistribution) ProtoMessage()    {}
+func (*YieldDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_904b969170e2d3ca, []int{2}
+}
+func (m *YieldDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *YieldDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_YieldDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *YieldDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_YieldDistribution.Merge(m, src)
+}
+func (m *YieldDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *YieldDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_YieldDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_YieldDistribution proto.InternalMessageInfo
+
+func (m *YieldDistribution) GetTotalTransferred() uint64 {
+	if m != nil {
+		return m.TotalTransferred
+	}
+	return 0
+}
+
+func (m *YieldDistribution) GetLastTransferAmount() uint64 {
+	if m != nil {
+		return m.LastTransferAmount
+	}
+	return 0
+}
+
+func (m *YieldDistribution) GetLastTransferHeight() int64 {
+	if m != nil {
+		return m.LastTransferHeight
+	}
+	return 0
+}
+
+func (m *YieldDistribution) GetLastTransferTime() time.Time {
+	if m != nil {
+		return m.LastTransferTime
+	}
+	return time.Time{}
+}
+
+// YieldShortfall records the yield verana_pool could not pay, owed to the trust
+// deposits until verana_pool is refilled.
+type YieldShortfall struct {
+	// amount is the unpaid yield still owed, in the yield denom.
+	Amount uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// total_recorded is the unpaid yield recorded since genesis.
+	TotalRecorded uint64 `protobuf:"varint,2,opt,name=total_recorded,json=totalRecorded,proto3" json:"total_recorded,omitempty"`
+	// total_repaid is the recorded yield paid back since genesis.
+	TotalRepaid         uint64 `protobuf:"varint,3,opt,name=total_repaid,json=totalRepaid,proto3" json:"total_repaid,omitempty"`
+	LastShortfallHeight int64  `protobuf:"varint,4,opt,name=last_shortfall_height,json=lastShortfallHeight,proto3" json:"last_shortfall_height,omitempty"`
+}
+
+func (m *YieldShortfall) Reset()         { *m = YieldShortfall{} }
+func (m *YieldShortfall) String() string { return proto.CompactTextString(m) }
+func (*YieldShortfall) ProtoMessage()    {}
+func (*YieldShortfall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_904b969170e2d3ca, []int{3}
+}
+func (m *YieldShortfall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *YieldShortfall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_YieldShortfall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *YieldShortfall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_YieldShortfall.Merge(m, src)
+}
+func (m *YieldShortfall) XXX_Size() int {
+	return m.Size()
+}
+func (m *YieldShortfall) XXX_DiscardUnknown() {
+	xxx_messageInfo_YieldShortfall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_YieldShortfall proto.InternalMessageInfo
+
+func (m *YieldShortfall) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *YieldShortfall) GetTotalRecorded() uint64 {
+	if m != nil {
+		return m.TotalRecorded
+	}
+	return 0
+}
+
+func (m *YieldShortfall) GetTotalRepaid() uint64 {
+	if m != nil {
+		return m.TotalRepaid
+	}
+	return 0
+}
+
+func (m *YieldShortfall) GetLastShortfallHeight() int64 {
+	if m != nil {
+		return m.LastShortfallHeight
+	}
+	return 0
+}
+
+// YieldCircuit tracks the failures of the yield transfers and sweeps of
+// verana_pool, which are paused after yield_failure_limit consecutive failures.
+type YieldCircuit struct {
+	ConsecutiveFailures uint32 `protobuf:"varint,1,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// paused is set once consecutive_failures reaches yield_failure_limit, and
+	// cleared by MsgResumeYield.
+	Paused            bool   `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	LastFailureHeight int64  `protobuf:"varint,3,opt,name=last_failure_height,json=lastFailureHeight,proto3" json:"last_failure_height,omitempty"`
+	LastError         string `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (m *YieldCircuit) Reset()         { *m = YieldCircuit{} }
+func (m *YieldCircuit) String() string { return proto.CompactTextString(m) }
+func (*YieldCircuit) ProtoMessage()    {}
+func (*YieldCircuit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_904b969170e2d3ca, []int{4}
+}
+func (m *YieldCircuit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *YieldCircuit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_YieldCircuit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *YieldCircuit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_YieldCircuit.Merge(m, src)
+}
+func (m *YieldCircuit) XXX_Size() int {
+	return m.Size()
+}
+func (m *YieldCircuit) XXX_DiscardUnknown() {
+	xxx_messageInfo_YieldCircuit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_YieldCircuit proto.InternalMessageInfo
+
+func (m *YieldCircuit) GetConsecutiveFailures() uint32 {
+	if m != nil {
+		return m.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (m *YieldCircuit) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *YieldCircuit) GetLastFailureHeight() int64 {
+	if m != nil {
+		return m.LastFailureHeight
+	}
+	return 0
+}
+
+func (m *YieldCircuit) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+// YieldAccrual holds the block time yield was last accrued at.
+type YieldAccrual struct {
+	LastAccrualTime time.Time `protobuf:"bytes,1,opt,name=last_accrual_time,json=lastAccrualTime,proto3,stdtime" json:"last_accrual_time"`
+}
+
+func (m *YieldAccrual) Reset()         { *m = YieldAccrual{} }
+func (m *YieldAccrual) String() string { return proto.CompactTextString(m) }
+func (*YieldAccrual) ProtoMessage()    {}
+func (*YieldAccrual) Descriptor() ([]byte, []int) {
+	return fileDescriptor_904b969170e2d3ca, []int{5}
+}
+func (m *YieldAccrual) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *YieldAccrual) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_YieldAccrual.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *YieldAccrual) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_YieldAccrual.Merge(m, src)
+}
+func (m *YieldAccrual) XXX_Size() int {
+	return m.Size()
+}
+func (m *YieldAccrual) XXX_DiscardUnknown() {
+	xxx_messageInfo_YieldAccrual.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_YieldAccrual proto.InternalMessageInfo
+
+func (m *YieldAccrual) GetLastAccrualTime() time.Time {
+	if m != nil {
+		return m.LastAccrualTime
+	}
+	return time.Time{}
+}
+
+// TrustDeposit is the trust deposit of an account. Its value is its share times
+// the trust deposit share value.
+type TrustDeposit struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// amount is the total amount deposited by the account, in uvna.
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// share is the number of shares held by the account.
+	Share cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=share,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share"`
+	// locked is the part of amount that cannot be reclaimed, e.g. the bond of
+	// an onboarded validator, in uvna.
+	Locked uint64 `protobuf:"varint,4,opt,name=locked,proto3" json:"locked,omitempty"`
+}
+
+func (m *TrustDeposit) Reset()         { *m = TrustDeposit{} }
+func (m *TrustDeposit) String() string { return proto.CompactTextString(m) }
+func (*TrustDeposit) ProtoMessage()    {}
+func (*TrustDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_904b969170e2d3ca, []int{6}
+}
+func (m *TrustDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrustDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrustDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrustDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustDeposit.Merge(m, src)
+}
+func (m *TrustDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrustDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrustDeposit proto.InternalMessageInfo
+
+func (m *TrustDeposit) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *TrustDeposit) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *TrustDeposit) GetLocked() uint64 {
+	if m != nil {
+		return m.Locked
+	}
+	return 0
+}
+
+// PendingWithdrawal is a reclaimed trust deposit waiting for its unbonding
+// period to end.
+type PendingWithdrawal struct {
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// amount is the amount to pay out, in uvna.
+	Amount         uint64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	CompletionTime time.Time `protobuf:"bytes,4,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+}
+
+func (m *PendingWithdrawal) Reset()         { *m = PendingWithdrawal{} }
+func (m *PendingWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*PendingWithdrawal) ProtoMessage()    {}
+func (*PendingWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_904b969170e2d3ca, []int{7}
+}
+func (m *PendingWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingWithdrawal.Merge(m, src)
+}
+func (m *PendingWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingWithdrawal proto.InternalMessageInfo
+
+func (m *PendingWithdrawal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PendingWithdrawal) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *PendingWithdrawal) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *PendingWithdrawal) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+// SlashRecord records a slash of the trust deposit of an account.
+type SlashRecord struct {
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// fraction is the fraction of the trust deposit that was slashed.
+	Fraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=fraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fraction"`
+	// amount is the amount slashed, in uvna, including pending withdrawals.
+	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// burned is true if the amount was burned, and false if it was sent to the
+	// community pool.
+	Burned bool      `protobuf:"varint,5,opt,name=burned,proto3" json:"burned,omitempty"`
+	Reason string    `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Height int64     `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time `protobuf:"bytes,8,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *SlashRecord) Reset()         { *m = SlashRecord{} }
+func (m *SlashRecord) String() string { return proto.CompactTextString(m) }
+func (*SlashRecord) ProtoMessage()    {}
+func (*SlashRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_904b969170e2d3ca, []int{8}
+}
+func (m *SlashRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashRecord.Merge(m, src)
+}
+func (m *SlashRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashRecord proto.InternalMessageInfo
+
+func (m *SlashRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *SlashRecord) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetBurned() bool {
+	if m != nil {
+		return m.Burned
+	}
+	return false
+}
+
+func (m *SlashRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *SlashRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*DustAmount)(nil), "veranatest.td.v1.DustAmount")
+	proto.RegisterType((*FeeSourcedFunding)(nil), "veranatest.td.v1.FeeSourcedFunding")
+	proto.RegisterType((*YieldDistribution)(nil), "veranatest.td.v1.YieldDistribution")
+	proto.RegisterType((*YieldShortfall)(nil), "veranatest.td.v1.YieldShortfall")
+	proto.RegisterType((*YieldCircuit)(nil), "veranatest.td.v1.YieldCircuit")
+	proto.RegisterType((*YieldAccrual)(nil), "veranatest.td.v1.YieldAccrual")
+	proto.RegisterType((*TrustDeposit)(nil), "veranatest.td.v1.TrustDeposit")
+	proto.RegisterType((*PendingWithdrawal)(nil), "veranatest.td.v1.PendingWithdrawal")
+	proto.RegisterType((*SlashRecord)(nil), "veranatest.td.v1.SlashRecord")
 }
 
 func init() { proto.RegisterFile("veranatest/td/v1/types.proto", fileDescriptor_904b969170e2d3ca) }
 
 var fileDescriptor_904b969170e2d3ca = []byte{
-	// 246 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0x4b, 0x2d, 0x4a,
-	0xcc, 0x4b, 0x2c, 0x49, 0x2d, 0x2e, 0xd1, 0x2f, 0x49, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0x2c,
-	0x48, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x40, 0xc8, 0xea, 0x95, 0xa4, 0xe8,
-	0x95, 0x19, 0x4a, 0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x22, 0x29, 0xf1,
-	0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0x62, 0xfd, 0xdc, 0xe2, 0x74, 0x90, 0xfe, 0xdc, 0xe2, 0x74, 0xa8,
-	0x84, 0x24, 0x44, 0x22, 0x1e, 0xcc, 0xd3, 0x87, 0x70, 0xa0, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9,
-	0x10, 0x71, 0x10, 0x0b, 0x2a, 0x2a, 0x8b, 0xe1, 0x98, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0x26,
-	0xa5, 0x10, 0x2e, 0x2e, 0x97, 0xd2, 0xe2, 0x12, 0xc7, 0xdc, 0xfc, 0xd2, 0xbc, 0x12, 0x21, 0x37,
-	0x2e, 0x96, 0x94, 0xd2, 0xe2, 0x12, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x4e, 0x27, 0xa3, 0x13, 0xf7,
-	0xe4, 0x19, 0x6e, 0xdd, 0x93, 0x97, 0x86, 0x58, 0x53, 0x9c, 0x92, 0xad, 0x97, 0x99, 0xaf, 0x9f,
-	0x9b, 0x58, 0x92, 0xa1, 0xe7, 0x93, 0x9a, 0x9e, 0x98, 0x5c, 0xe9, 0x92, 0x9a, 0xfc, 0xe9, 0x9e,
-	0x3c, 0x77, 0x65, 0x62, 0x6e, 0x8e, 0x95, 0x12, 0x48, 0xa3, 0x52, 0x10, 0x58, 0xbf, 0x93, 0xfe,
-	0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c,
-	0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x89, 0x22, 0x39, 0xa7, 0x02, 0xe4,
-	0x20, 0x70, 0xd0, 0x24, 0xb1, 0x81, 0x5d, 0x63, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x44, 0xfc,
-	0x3b, 0xa5, 0x3b, 0x01, 0x00, 0x00,
+	// 902 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x3a, 0x6e, 0x6a, 0x8f, 0xd3, 0x34, 0x9e, 0xa6, 0xc5, 0x0d, 0xd4, 0x0e, 0x8b, 0x90,
+	0x2c, 0x50, 0x77, 0xb1, 0x11, 0x12, 0x70, 0x41, 0x71, 0x43, 0xc4, 0x01, 0xa4, 0x6a, 0x13, 0x09,
+	0xc1, 0xc5, 0x8c, 0x67, 0xc6, 0xbb, 0xa3, 0xec, 0xee, 0x58, 0x33, 0xb3, 0x86, 0x1c, 0xf8, 0x0f,
+	0xfd, 0x15, 0xa8, 0x42, 0x20, 0x71, 0xe0, 0x88, 0x38, 0xf7, 0x58, 0x71, 0x42, 0x1c, 0x52, 0x94,
+	0x1c, 0xb8, 0xf3, 0x0b, 0xd0, 0xbc, 0x9d, 0x75, 0x6c, 0xf5, 0x52, 0x4b, 0x5c, 0x12, 0xbf, 0xf7,
+	0xbd, 0xf7, 0xe6, 0xbd, 0xef, 0x7b, 0x33, 0x8b, 0xde, 0x98, 0x73, 0x45, 0x72, 0x62, 0xb8, 0x36,
+	0xa1, 0x61, 0xe1, 0x7c, 0x10, 0x9a, 0xf3, 0x19, 0xd7, 0xc1, 0x4c, 0x49, 0x23, 0xf1, 0xee, 0x35,
+	0x1a, 0x18, 0x16, 0xcc, 0x07, 0xfb, 0x6d, 0x92, 0x89, 0x5c, 0x86, 0xf0, 0xb7, 0x0c, 0xda, 0xef,
+	0x52, 0xa9, 0x33, 0xa9, 0xc3, 0x09, 0xd1, 0x3c, 0x9c, 0x0f, 0x26, 0xdc, 0x90, 0x41, 0x48, 0xa5,
+	0xc8, 0x1d, 0xfe, 0x9a, 0xc3, 0x33, 0x1d, 0xdb, 0xfa, 0x99, 0x8e, 0x1d, 0x70, 0xbf, 0x04, 0xc6,
+	0x60, 0x85, 0xa5, 0xe1, 0xa0, 0xbd, 0x58, 0xc6, 0xb2, 0xf4, 0xdb, 0x5f, 0xce, 0xdb, 0x8b, 0xa5,
+	0x8c, 0x53, 0x1e, 0x82, 0x35, 0x29, 0xa6, 0xa1, 0x11, 0x19, 0xd7, 0x86, 0x64, 0x33, 0x17, 0xf0,
+	0xe0, 0xa5, 0x69, 0x66, 0x44, 0x91, 0xcc, 0x55, 0xf5, 0x4f, 0x11, 0x3a, 0x2a, 0xb4, 0x39, 0xcc,
+	0x64, 0x91, 0x1b, 0x7c, 0x8c, 0xea, 0xac, 0xd0, 0xa6, 0xe3, 0x1d, 0x78, 0xfd, 0xe6, 0x68, 0xf8,
+	0xec, 0xa2, 0xb7, 0xf1, 0xd7, 0x45, 0xef, 0xf5, 0xb2, 0x0f, 0xcd, 0xce, 0x02, 0x21, 0xc3, 0x8c,
+	0x98, 0x24, 0xf8, 0x9c, 0xc7, 0x84, 0x9e, 0x1f, 0x71, 0xfa, 0xef, 0x45, 0xaf, 0x75, 0x4e, 0xb2,
+	0xf4, 0x63, 0xdf, 0x26, 0xfa, 0x11, 0xe4, 0xfb, 0xdf, 0xa3, 0xf6, 0x31, 0xe7, 0x27, 0xb2, 0x50,
+	0x94, 0xb3, 0xe3, 0x22, 0x67, 0x22, 0x8f, 0x71, 0x82, 0xb6, 0x08, 0x1c, 0xd3, 0xf1, 0x0e, 0x36,
+	0xfb, 0xad, 0xe1, 0xfd, 0xc0, 0xcd, 0x67, 0x59, 0x0a, 0x1c, 0x4b, 0xc1, 0x23, 0x29, 0xf2, 0xd1,
+	0x07, 0xf6, 0xe4, 0x1f, 0x5f, 0xf4, 0xfa, 0xb1, 0x30, 0x49, 0x31, 0x09, 0xa8, 0xcc, 0x1c, 0x19,
+	0xee, 0xdf, 0x43, 0xcd, 0xce, 0x9c, 0x2c, 0x36, 0x41, 0x3f, 0xfd, 0xe7, 0x97, 0x77, 0xbc, 0xc8,
+	0xd5, 0xf7, 0x7f, 0xab, 0xa1, 0xf6, 0x57, 0x82, 0xa7, 0xec, 0x48, 0x68, 0xa3, 0xc4, 0xa4, 0x30,
+	0x42, 0xe6, 0xf8, 0x5d, 0xd4, 0x36, 0xd2, 0x90, 0x74, 0x6c, 0x14, 0xc9, 0xf5, 0x94, 0x2b, 0xc5,
+	0x19, 0x4c, 0x5a, 0x8f, 0x76, 0x01, 0x38, 0xbd, 0xf6, 0xe3, 0xf7, 0xd0, 0x5e, 0x4a, 0xb4, 0x59,
+	0xc4, 0x8e, 0x5d, 0xeb, 0x35, 0x88, 0xc7, 0x16, 0xab, 0xc2, 0x1d, 0x77, 0x2f, 0x65, 0x24, 0x5c,
+	0xc4, 0x89, 0xe9, 0x6c, 0x1e, 0x78, 0xfd, 0xcd, 0xd5, 0x8c, 0xcf, 0x00, 0xc1, 0x11, 0xc2, 0xab,
+	0x19, 0x56, 0xbb, 0x4e, 0xfd, 0xc0, 0xeb, 0xb7, 0x86, 0xfb, 0x41, 0x29, 0x6c, 0x50, 0x09, 0x1b,
+	0x9c, 0x56, 0xc2, 0x8e, 0x1a, 0x96, 0x9d, 0x27, 0x2f, 0x7a, 0x5e, 0xb4, 0xbb, 0x5c, 0xd5, 0x06,
+	0xe0, 0x11, 0x42, 0x73, 0x92, 0x16, 0xbc, 0xac, 0x75, 0x03, 0x74, 0x7c, 0xeb, 0x15, 0x74, 0x8c,
+	0x9a, 0x90, 0x66, 0x6b, 0xf8, 0x3f, 0x78, 0x68, 0x07, 0xe8, 0x3b, 0x49, 0xa4, 0x32, 0x53, 0x92,
+	0xa6, 0xf8, 0xde, 0x92, 0x76, 0x96, 0x00, 0x67, 0xe1, 0xb7, 0xd1, 0x4e, 0xc9, 0xa9, 0xe2, 0x54,
+	0x2a, 0xc6, 0x99, 0x23, 0xe8, 0x16, 0x78, 0x23, 0xe7, 0xc4, 0x6f, 0xa2, 0xed, 0x2a, 0x6c, 0x46,
+	0x04, 0x03, 0x4e, 0xea, 0x51, 0xcb, 0x05, 0x59, 0x17, 0x1e, 0xa2, 0xbb, 0x40, 0x86, 0xae, 0xce,
+	0xac, 0xf8, 0xab, 0x03, 0x7f, 0x77, 0x2c, 0xb8, 0xe8, 0xa7, 0x24, 0xd0, 0x7f, 0xea, 0xa1, 0x6d,
+	0x68, 0xf4, 0x91, 0x50, 0xb4, 0x10, 0x06, 0x0f, 0xd0, 0x1e, 0x95, 0xb9, 0xe6, 0xb4, 0x30, 0x62,
+	0xce, 0xc7, 0x53, 0x22, 0xd2, 0x42, 0x71, 0x0d, 0x4d, 0xdf, 0x8a, 0xee, 0x2c, 0x61, 0xc7, 0x0e,
+	0xb2, 0x93, 0xcd, 0x48, 0xa1, 0x5d, 0xe7, 0x8d, 0xc8, 0x59, 0x38, 0x40, 0x70, 0x64, 0x55, 0x63,
+	0x55, 0xcd, 0xb6, 0x85, 0x5c, 0x09, 0x27, 0xe6, 0x03, 0x84, 0x20, 0x9e, 0x2b, 0x25, 0x15, 0x34,
+	0xdd, 0x8c, 0x9a, 0xd6, 0xf3, 0xa9, 0x75, 0xf8, 0xdf, 0xb8, 0x4e, 0x0f, 0x29, 0x55, 0x05, 0x49,
+	0xf1, 0x63, 0x04, 0x35, 0xc6, 0xa4, 0xb4, 0x4b, 0xb9, 0xbc, 0x35, 0xa4, 0xbf, 0x6d, 0xd3, 0x5d,
+	0x35, 0x50, 0xed, 0x67, 0x0f, 0x6d, 0x9f, 0xaa, 0x42, 0x9b, 0x23, 0x3e, 0x93, 0x5a, 0x18, 0x3c,
+	0x44, 0x37, 0x09, 0xa5, 0x0b, 0xd1, 0x9a, 0xa3, 0xce, 0x1f, 0xbf, 0x3e, 0xdc, 0x73, 0x77, 0xee,
+	0x90, 0x31, 0xc5, 0xb5, 0x3e, 0x31, 0x4a, 0xe4, 0x71, 0x54, 0x05, 0x2e, 0xe9, 0x5c, 0x5b, 0xd1,
+	0xf9, 0x23, 0x74, 0x43, 0x27, 0x44, 0xf1, 0xce, 0xe6, 0xab, 0x6f, 0x54, 0x99, 0x61, 0x4b, 0xa6,
+	0x92, 0x9e, 0x71, 0x06, 0xa4, 0xd4, 0x23, 0x67, 0xf9, 0xbf, 0x7b, 0xa8, 0xfd, 0x98, 0xc3, 0xd3,
+	0xf0, 0xa5, 0x30, 0x09, 0x53, 0xe4, 0x5b, 0x92, 0xe2, 0x1d, 0x54, 0x13, 0xd5, 0xad, 0xac, 0xc1,
+	0x5a, 0x2c, 0x86, 0xa8, 0xad, 0x3f, 0xc4, 0xe6, 0xca, 0x10, 0x5f, 0xa0, 0xdb, 0x54, 0x66, 0xb3,
+	0x94, 0xdb, 0xe7, 0x60, 0xfd, 0xcb, 0xb6, 0x73, 0x9d, 0x0c, 0x84, 0xff, 0x54, 0x43, 0xad, 0x93,
+	0x94, 0xe8, 0xa4, 0x5c, 0xf3, 0xff, 0xa5, 0xf5, 0x4f, 0x50, 0x63, 0xaa, 0x08, 0xb5, 0x67, 0xac,
+	0x43, 0xf5, 0x22, 0x69, 0x69, 0xf6, 0xfa, 0xca, 0xec, 0xf7, 0xd0, 0xd6, 0xa4, 0x50, 0x39, 0x67,
+	0xf0, 0x26, 0x34, 0x22, 0x67, 0x59, 0xbf, 0xe2, 0x44, 0xcb, 0xbc, 0xb3, 0x05, 0x2b, 0xeb, 0x2c,
+	0xeb, 0x77, 0x1b, 0x7f, 0x13, 0x36, 0xde, 0x59, 0xf8, 0x43, 0x54, 0x07, 0xe2, 0x1a, 0x6b, 0x10,
+	0x07, 0x19, 0xa3, 0xf0, 0xd9, 0x65, 0xd7, 0x7b, 0x7e, 0xd9, 0xf5, 0xfe, 0xbe, 0xec, 0x7a, 0x4f,
+	0xae, 0xba, 0x1b, 0xcf, 0xaf, 0xba, 0x1b, 0x7f, 0x5e, 0x75, 0x37, 0xbe, 0xbe, 0xbb, 0xf4, 0x89,
+	0xfa, 0xce, 0x7e, 0xa4, 0xe0, 0x61, 0x9f, 0x6c, 0x41, 0xd1, 0xf7, 0xff, 0x1b, 0x00, 0x89, 0x59,
+	0x52, 0x3b, 0x90, 0x07, 0x00, 0x00,
 }
 
 func (m *DustAmount) Marshal() (dAtA []byte, err error) {
@@ -116,42 +717,1601 @@ func (m *DustAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if _, err := m.Dust.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSourcedFunding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSourcedFunding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSourcedFunding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *YieldDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *YieldDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *YieldDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ValueTime.Size()
+		i -= size
+		if _, err := m.ValueTime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastTransferTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastTransferTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTypes(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.LastTransferHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastTransferHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastTransferAmount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastTransferAmount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TotalTransferred != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalTransferred))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *YieldShortfall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *YieldShortfall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *YieldShortfall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastShortfallHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastShortfallHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalRepaid != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalRepaid))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalRecorded != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalRecorded))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *YieldCircuit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *YieldCircuit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *YieldCircuit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LastFailureHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastFailureHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ConsecutiveFailures != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ConsecutiveFailures))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *YieldAccrual) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *YieldAccrual) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *YieldAccrual) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastAccrualTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastAccrualTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTypes(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TrustDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrustDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrustDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Locked != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Locked))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Amount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTypes(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if m.Amount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTypes(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x42
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Burned {
+		i--
+		if m.Burned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DustAmount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Dust.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *FeeSourcedFunding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *YieldDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalTransferred != 0 {
+		n += 1 + sovTypes(uint64(m.TotalTransferred))
+	}
+	if m.LastTransferAmount != 0 {
+		n += 1 + sovTypes(uint64(m.LastTransferAmount))
+	}
+	if m.LastTransferHeight != 0 {
+		n += 1 + sovTypes(uint64(m.LastTransferHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastTransferTime)
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ValueTime.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *YieldShortfall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		n += 1 + sovTypes(uint64(m.Amount))
+	}
+	if m.TotalRecorded != 0 {
+		n += 1 + sovTypes(uint64(m.TotalRecorded))
+	}
+	if m.TotalRepaid != 0 {
+		n += 1 + sovTypes(uint64(m.TotalRepaid))
+	}
+	if m.LastShortfallHeight != 0 {
+		n += 1 + sovTypes(uint64(m.LastShortfallHeight))
+	}
+	return n
+}
+
+func (m *YieldCircuit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConsecutiveFailures != 0 {
+		n += 1 + sovTypes(uint64(m.ConsecutiveFailures))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if m.LastFailureHeight != 0 {
+		n += 1 + sovTypes(uint64(m.LastFailureHeight))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *YieldAccrual) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastAccrualTime)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *TrustDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTypes(uint64(m.Amount))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Locked != 0 {
+		n += 1 + sovTypes(uint64(m.Locked))
+	}
+	return n
+}
+
+func (m *PendingWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTypes(uint64(m.Amount))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *SlashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Amount != 0 {
+		n += 1 + sovTypes(uint64(m.Amount))
+	}
+	if m.Burned {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DustAmount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DustAmount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DustAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Dust.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSourcedFunding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSourcedFunding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSourcedFunding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *YieldDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: YieldDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: YieldDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTransferred", wireType)
+			}
+			m.TotalTransferred = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalTransferred |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransferAmount", wireType)
+			}
+			m.LastTransferAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTransferAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransferHeight", wireType)
+			}
+			m.LastTransferHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTransferHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransferTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastTransferTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValueTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *YieldShortfall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: YieldShortfall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: YieldShortfall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRecorded", wireType)
+			}
+			m.TotalRecorded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalRecorded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRepaid", wireType)
+			}
+			m.TotalRepaid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalRepaid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastShortfallHeight", wireType)
+			}
+			m.LastShortfallHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastShortfallHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *YieldCircuit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: YieldCircuit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: YieldCircuit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailureHeight", wireType)
+			}
+			m.LastFailureHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastFailureHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *YieldAccrual) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: YieldAccrual: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: YieldAccrual: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAccrualTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastAccrualTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrustDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrustDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrustDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			m.Locked = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Locked |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *DustAmount) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Dust.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *DustAmount) Unmarshal(dAtA []byte) error {
+func (m *SlashRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -174,15 +2334,34 @@ func (m *DustAmount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DustAmount: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DustAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -210,7 +2389,162 @@ func (m *DustAmount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Dust.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Burned = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
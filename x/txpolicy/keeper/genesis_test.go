@@ -0,0 +1,24 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"veranatest/x/txpolicy/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesis(t *testing.T) {
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+	}
+
+	f := initFixture(t)
+	err := f.keeper.InitGenesis(f.ctx, genesisState)
+	require.NoError(t, err)
+	got, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	require.EqualExportedValues(t, genesisState.Params, got.Params)
+}
@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"veranatest/x/txpolicy/keeper"
+	"veranatest/x/txpolicy/types"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	// default params
+	testCases := []struct {
+		name      string
+		input     *types.MsgUpdateParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			input: &types.MsgUpdateParams{
+				Authority: "invalid",
+				Params:    params,
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "deny council message",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    msgTypeParams(nil, []string{"/cosmos.group.v1.MsgVote"}),
+			},
+			expErr:    true,
+			expErrMsg: "required by the council",
+		},
+		{
+			name: "deny gov proposals",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    msgTypeParams(nil, []string{"/cosmos.gov.v1.MsgSubmitProposal"}),
+			},
+			expErr: false,
+		},
+		{
+			name: "all good",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    params,
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.UpdateParams(f.ctx, tc.input)
+
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+
+				got, err := f.keeper.Params.Get(f.ctx)
+				require.NoError(t, err)
+				require.Equal(t, tc.input.Params, got)
+			}
+		})
+	}
+}
+
+// msgTypeParams returns the default params with the given allowed and denied
+// message type URLs.
+func msgTypeParams(allowed, denied []string) types.Params {
+	params := types.DefaultParams()
+	params.AllowedMsgTypeUrls = allowed
+	params.DeniedMsgTypeUrls = denied
+	return params
+}
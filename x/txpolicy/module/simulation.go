@@ -0,0 +1,32 @@
+package txpolicy
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"veranatest/x/txpolicy/types"
+)
+
+// GenerateGenesisState creates a randomized GenState of the module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	// nothing is denied, so the operations of x/gov and x/nft can be simulated
+	params := types.DefaultParams()
+	params.DeniedMsgTypeUrls = nil
+	txpolicyGenesis := types.GenesisState{
+		Params: params,
+	}
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&txpolicyGenesis)
+}
+
+// RegisterStoreDecoder registers a decoder.
+func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
+
+// WeightedOperations returns the all the txpolicy module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return []simtypes.WeightedOperation{}
+}
+
+// ProposalMsgs returns msgs used for governance proposals for simulations.
+func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{}
+}
@@ -0,0 +1,16 @@
+package types
+
+// DONTCOVER
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// x/txpolicy module sentinel errors
+var (
+	ErrInvalidSigner             = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrMsgTypeDenied             = errors.Register(ModuleName, 1101, "message type is denied")
+	ErrMsgTypeNotAllowed         = errors.Register(ModuleName, 1102, "message type is not allowed")
+	ErrInvalidMsgTypeURL         = errors.Register(ModuleName, 1103, "invalid message type url")
+	ErrInvalidGasPriceMultiplier = errors.Register(ModuleName, 1104, "invalid gas price multiplier")
+)
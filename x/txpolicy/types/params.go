@@ -0,0 +1,175 @@
+package types
+
+import (
+	"slices"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/nft"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// CouncilMsgTypeURLs returns the messages the council needs to update the params
+// of this module through a group proposal. They can never be denied.
+func CouncilMsgTypeURLs() []string {
+	return []string{
+		sdk.MsgTypeURL(&group.MsgSubmitProposal{}),
+		sdk.MsgTypeURL(&group.MsgVote{}),
+		sdk.MsgTypeURL(&group.MsgExec{}),
+		sdk.MsgTypeURL(&MsgUpdateParams{}),
+	}
+}
+
+// DefaultDeniedMsgTypeURLs returns the messages denied on a new chain: x/gov
+// proposals, as the chain is governed by the council, and x/nft transfers.
+func DefaultDeniedMsgTypeURLs() []string {
+	return []string{
+		sdk.MsgTypeURL(&govv1.MsgSubmitProposal{}),
+		sdk.MsgTypeURL(&govv1beta1.MsgSubmitProposal{}),
+		sdk.MsgTypeURL(&nft.MsgSend{}),
+	}
+}
+
+const (
+	// DefaultFeeDenom is the denom of the default consensus minimum gas price.
+	DefaultFeeDenom = "uvna"
+
+	// DefaultCouncilFeeExemption enables the council fee exemption by default.
+	DefaultCouncilFeeExemption = true
+)
+
+// NewParams creates a new Params instance.
+func NewParams(
+	allowedMsgTypeURLs, deniedMsgTypeURLs []string,
+	minGasPrice sdk.DecCoin,
+	msgGasPriceMultipliers []MsgGasPriceMultiplier,
+	councilFeeExemption bool,
+) Params {
+	return Params{
+		AllowedMsgTypeUrls:     allowedMsgTypeURLs,
+		DeniedMsgTypeUrls:      deniedMsgTypeURLs,
+		MinGasPrice:            minGasPrice,
+		MsgGasPriceMultipliers: msgGasPriceMultipliers,
+		CouncilFeeExemption:    councilFeeExemption,
+	}
+}
+
+// DefaultParams returns a default set of parameters. The consensus minimum gas
+// price is zero, leaving fees to the validators' local min-gas-prices.
+func DefaultParams() Params {
+	return NewParams(
+		nil,
+		DefaultDeniedMsgTypeURLs(),
+		sdk.NewDecCoin(DefaultFeeDenom, math.ZeroInt()),
+		nil,
+		DefaultCouncilFeeExemption,
+	)
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if err := validateMsgTypeURLs(p.AllowedMsgTypeUrls); err != nil {
+		return errorsmod.Wrap(err, "allowed msg type urls")
+	}
+	if err := validateMsgTypeURLs(p.DeniedMsgTypeUrls); err != nil {
+		return errorsmod.Wrap(err, "denied msg type urls")
+	}
+
+	for _, msgTypeURL := range p.DeniedMsgTypeUrls {
+		if slices.Contains(p.AllowedMsgTypeUrls, msgTypeURL) {
+			return errorsmod.Wrapf(ErrInvalidMsgTypeURL, "%s is both allowed and denied", msgTypeURL)
+		}
+	}
+
+	// an unset min gas price disables the consensus minimum
+	if p.MinGasPrice.Denom != "" || !p.MinGasPrice.Amount.IsNil() {
+		if err := p.MinGasPrice.Validate(); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "min gas price: %s", err)
+		}
+	}
+
+	seen := make(map[string]struct{}, len(p.MsgGasPriceMultipliers))
+	for _, m := range p.MsgGasPriceMultipliers {
+		if err := m.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seen[m.MsgTypeUrl]; ok {
+			return errorsmod.Wrapf(ErrInvalidMsgTypeURL, "duplicate gas price multiplier for %s", m.MsgTypeUrl)
+		}
+		seen[m.MsgTypeUrl] = struct{}{}
+	}
+
+	// the council must always be able to change these params again
+	for _, msgTypeURL := range CouncilMsgTypeURLs() {
+		if err := p.CheckMsgTypeURL(msgTypeURL); err != nil {
+			return errorsmod.Wrapf(err, "%s is required by the council", msgTypeURL)
+		}
+	}
+
+	return nil
+}
+
+// CheckMsgTypeURL returns an error if the message type is denied, or if an allow
+// list is set and does not contain it.
+func (p Params) CheckMsgTypeURL(msgTypeURL string) error {
+	if slices.Contains(p.DeniedMsgTypeUrls, msgTypeURL) {
+		return errorsmod.Wrap(ErrMsgTypeDenied, msgTypeURL)
+	}
+	if len(p.AllowedMsgTypeUrls) > 0 && !slices.Contains(p.AllowedMsgTypeUrls, msgTypeURL) {
+		return errorsmod.Wrap(ErrMsgTypeNotAllowed, msgTypeURL)
+	}
+
+	return nil
+}
+
+// GasPriceMultiplier returns the gas price multiplier of the given message
+// type, or 1 if it has none.
+func (p Params) GasPriceMultiplier(msgTypeURL string) math.LegacyDec {
+	for _, m := range p.MsgGasPriceMultipliers {
+		if m.MsgTypeUrl == msgTypeURL {
+			return m.Multiplier
+		}
+	}
+
+	return math.LegacyOneDec()
+}
+
+// Validate validates the gas price multiplier.
+func (m MsgGasPriceMultiplier) Validate() error {
+	if err := validateMsgTypeURL(m.MsgTypeUrl); err != nil {
+		return err
+	}
+	if m.Multiplier.IsNil() || m.Multiplier.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidGasPriceMultiplier, "%s: %s", m.MsgTypeUrl, m.Multiplier)
+	}
+
+	return nil
+}
+
+func validateMsgTypeURL(msgTypeURL string) error {
+	if !strings.HasPrefix(msgTypeURL, "/") || strings.TrimSpace(msgTypeURL) != msgTypeURL {
+		return errorsmod.Wrapf(ErrInvalidMsgTypeURL, "%q must start with '/' and contain no surrounding whitespace", msgTypeURL)
+	}
+
+	return nil
+}
+
+func validateMsgTypeURLs(msgTypeURLs []string) error {
+	seen := make(map[string]struct{}, len(msgTypeURLs))
+	for _, msgTypeURL := range msgTypeURLs {
+		if err := validateMsgTypeURL(msgTypeURL); err != nil {
+			return err
+		}
+		if _, ok := seen[msgTypeURL]; ok {
+			return errorsmod.Wrapf(ErrInvalidMsgTypeURL, "duplicate %s", msgTypeURL)
+		}
+		seen[msgTypeURL] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,864 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: veranatest/txpolicy/v1/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the module.
+type Params struct {
+	// allowed_msg_type_urls restricts transactions to the listed message types.
+	// An empty list allows every message type that is not denied.
+	AllowedMsgTypeUrls []string `protobuf:"bytes,1,rep,name=allowed_msg_type_urls,json=allowedMsgTypeUrls,proto3" json:"allowed_msg_type_urls,omitempty"`
+	// denied_msg_type_urls lists the message types that are rejected.
+	DeniedMsgTypeUrls []string `protobuf:"bytes,2,rep,name=denied_msg_type_urls,json=deniedMsgTypeUrls,proto3" json:"denied_msg_type_urls,omitempty"`
+	// min_gas_price is the consensus minimum gas price. Unlike the validator local
+	// min-gas-prices it is enforced in every block, not only in CheckTx.
+	MinGasPrice types.DecCoin `protobuf:"bytes,3,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price"`
+	// msg_gas_price_multipliers scale min_gas_price for transactions containing
+	// the listed message types. The highest multiplier of all messages applies,
+	// and messages without an entry use a multiplier of 1.
+	MsgGasPriceMultipliers []MsgGasPriceMultiplier `protobuf:"bytes,4,rep,name=msg_gas_price_multipliers,json=msgGasPriceMultipliers,proto3" json:"msg_gas_price_multipliers"`
+	// council_fee_exemption waives the fees of transactions signed by council
+	// members that only submit, vote on, execute or withdraw council proposals.
+	CouncilFeeExemption bool `protobuf:"varint,5,opt,name=council_fee_exemption,json=councilFeeExemption,proto3" json:"council_fee_exemption,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9873c2e6484eff7, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetAllowedMsgTypeUrls() []string {
+	if m != nil {
+		return m.AllowedMsgTypeUrls
+	}
+	return nil
+}
+
+func (m *Params) GetDeniedMsgTypeUrls() []string {
+	if m != nil {
+		return m.DeniedMsgTypeUrls
+	}
+	return nil
+}
+
+func (m *Params) GetMinGasPrice() types.DecCoin {
+	if m != nil {
+		return m.MinGasPrice
+	}
+	return types.DecCoin{}
+}
+
+func (m *Params) GetMsgGasPriceMultipliers() []MsgGasPriceMultiplier {
+	if m != nil {
+		return m.MsgGasPriceMultipliers
+	}
+	return nil
+}
+
+func (m *Params) GetCouncilFeeExemption() bool {
+	if m != nil {
+		return m.CouncilFeeExemption
+	}
+	return false
+}
+
+// MsgGasPriceMultiplier scales the minimum gas price for a message type.
+type MsgGasPriceMultiplier struct {
+	MsgTypeUrl string                      `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	Multiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"multiplier"`
+}
+
+func (m *MsgGasPriceMultiplier) Reset()         { *m = MsgGasPriceMultiplier{} }
+func (m *MsgGasPriceMultiplier) String() string { return proto.CompactTextString(m) }
+func (*MsgGasPriceMultiplier) ProtoMessage()    {}
+func (*MsgGasPriceMultiplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9873c2e6484eff7, []int{1}
+}
+func (m *MsgGasPriceMultiplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGasPriceMultiplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGasPriceMultiplier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGasPriceMultiplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGasPriceMultiplier.Merge(m, src)
+}
+func (m *MsgGasPriceMultiplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGasPriceMultiplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGasPriceMultiplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGasPriceMultiplier proto.InternalMessageInfo
+
+func (m *MsgGasPriceMultiplier) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "veranatest.txpolicy.v1.Params")
+	proto.RegisterType((*MsgGasPriceMultiplier)(nil), "veranatest.txpolicy.v1.MsgGasPriceMultiplier")
+}
+
+func init() {
+	proto.RegisterFile("veranatest/txpolicy/v1/params.proto", fileDescriptor_a9873c2e6484eff7)
+}
+
+var fileDescriptor_a9873c2e6484eff7 = []byte{
+	// 483 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x73, 0x4d, 0xa8, 0xc8, 0x05, 0x86, 0x9a, 0xa6, 0x72, 0xff, 0xc8, 0xb1, 0x8a, 0x90,
+	0xa2, 0x4a, 0xf5, 0xc9, 0x41, 0x2c, 0x1d, 0x43, 0x80, 0x01, 0x22, 0x15, 0x0b, 0x16, 0x16, 0xeb,
+	0x72, 0x79, 0x31, 0x27, 0x7c, 0x77, 0x96, 0xcf, 0x09, 0xc9, 0xce, 0xc4, 0x84, 0xf8, 0x04, 0x8c,
+	0x8c, 0x1d, 0xf8, 0x10, 0x1d, 0x2b, 0x26, 0xc4, 0x50, 0xa1, 0x64, 0x28, 0x1f, 0x03, 0x9d, 0xed,
+	0xfc, 0x91, 0x9a, 0xc5, 0xf2, 0xdd, 0xef, 0x7d, 0xdf, 0x7b, 0x9e, 0x47, 0x2f, 0x7e, 0x38, 0x86,
+	0x94, 0x4a, 0x9a, 0x81, 0xce, 0x48, 0x36, 0x49, 0x54, 0xcc, 0xd9, 0x94, 0x8c, 0x7d, 0x92, 0xd0,
+	0x94, 0x0a, 0xed, 0x25, 0xa9, 0xca, 0x94, 0xb5, 0xb7, 0x2a, 0xf2, 0x16, 0x45, 0xde, 0xd8, 0x3f,
+	0xd8, 0xa1, 0x82, 0x4b, 0x45, 0xf2, 0x6f, 0x51, 0x7a, 0xe0, 0x30, 0xa5, 0x85, 0xd2, 0x64, 0x40,
+	0x35, 0x90, 0xb1, 0x3f, 0x80, 0x8c, 0xfa, 0x84, 0x29, 0x2e, 0x4b, 0xbe, 0x5f, 0xf0, 0x30, 0x3f,
+	0x91, 0xe2, 0x50, 0xa2, 0xdd, 0x48, 0x45, 0xaa, 0xb8, 0x37, 0x7f, 0xc5, 0xed, 0xf1, 0xe7, 0x2a,
+	0xde, 0x3e, 0xcf, 0xc5, 0x58, 0x3e, 0x6e, 0xd2, 0x38, 0x56, 0x9f, 0x60, 0x18, 0x0a, 0x1d, 0x85,
+	0xd9, 0x34, 0x81, 0x70, 0x94, 0xc6, 0xda, 0x46, 0x6e, 0xb5, 0x5d, 0x0f, 0xac, 0x12, 0xf6, 0x75,
+	0xf4, 0x66, 0x9a, 0xc0, 0xdb, 0x34, 0xd6, 0x16, 0xc1, 0xbb, 0x43, 0x90, 0xfc, 0x56, 0xc7, 0x56,
+	0xde, 0xb1, 0x53, 0xb0, 0xf5, 0x86, 0x97, 0xf8, 0xbe, 0xe0, 0x32, 0x8c, 0xa8, 0x91, 0xc8, 0x19,
+	0xd8, 0x55, 0x17, 0xb5, 0x1b, 0x9d, 0x23, 0xaf, 0x94, 0x6a, 0x7c, 0x79, 0xa5, 0x2f, 0xaf, 0x07,
+	0xec, 0xa9, 0xe2, 0xb2, 0x5b, 0xbf, 0xbc, 0x6e, 0x55, 0x7e, 0xdc, 0x5c, 0x9c, 0xa0, 0xa0, 0x21,
+	0xb8, 0x7c, 0x41, 0xf5, 0xb9, 0xe9, 0xb5, 0x24, 0xde, 0x37, 0xcf, 0x2e, 0x87, 0x85, 0x62, 0x14,
+	0x67, 0x3c, 0x89, 0x39, 0xa4, 0xda, 0xae, 0xb9, 0xd5, 0x76, 0xa3, 0x73, 0xea, 0x6d, 0xce, 0xd6,
+	0xeb, 0xeb, 0x68, 0x31, 0xa7, 0xbf, 0xec, 0xea, 0xd6, 0xcc, 0x4b, 0xc1, 0x9e, 0xd8, 0x04, 0xb5,
+	0xd5, 0xc1, 0x4d, 0xa6, 0x46, 0x92, 0xf1, 0x38, 0x7c, 0x0f, 0x10, 0xc2, 0x04, 0x44, 0x92, 0x71,
+	0x25, 0xed, 0x3b, 0x2e, 0x6a, 0xdf, 0x0d, 0x1e, 0x94, 0xf0, 0x39, 0xc0, 0xb3, 0x05, 0x3a, 0x7b,
+	0xf4, 0xef, 0x7b, 0x0b, 0x7d, 0xb9, 0xb9, 0x38, 0x39, 0x5a, 0xdb, 0x84, 0xc9, 0x6a, 0x17, 0x8a,
+	0xec, 0x8f, 0xbf, 0x21, 0xdc, 0xdc, 0x28, 0xc9, 0x72, 0xf1, 0xbd, 0xf5, 0x6c, 0x6d, 0xe4, 0xa2,
+	0x76, 0x3d, 0xc0, 0x62, 0x19, 0xaa, 0xf5, 0x1a, 0xe3, 0x95, 0x71, 0x7b, 0xcb, 0xf0, 0xae, 0x6f,
+	0x8c, 0xfc, 0xb9, 0x6e, 0x1d, 0x16, 0xb9, 0xea, 0xe1, 0x47, 0x8f, 0x2b, 0x22, 0x68, 0xf6, 0xc1,
+	0x7b, 0x05, 0x11, 0x65, 0xd3, 0x1e, 0xb0, 0x5f, 0x3f, 0x4f, 0x71, 0x19, 0x7b, 0x0f, 0x58, 0xb0,
+	0x36, 0xe4, 0xac, 0x66, 0x54, 0x77, 0x9f, 0x5c, 0xce, 0x1c, 0x74, 0x35, 0x73, 0xd0, 0xdf, 0x99,
+	0x83, 0xbe, 0xce, 0x9d, 0xca, 0xd5, 0xdc, 0xa9, 0xfc, 0x9e, 0x3b, 0x95, 0x77, 0x87, 0x9b, 0xcd,
+	0x18, 0x91, 0x7a, 0xb0, 0x9d, 0x6f, 0xd6, 0xe3, 0xff, 0x03, 0x00, 0xbe, 0x38, 0xee, 0xa3, 0xfc,
+	0x02, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.AllowedMsgTypeUrls) != len(that1.AllowedMsgTypeUrls) {
+		return false
+	}
+	for i := range this.AllowedMsgTypeUrls {
+		if this.AllowedMsgTypeUrls[i] != that1.AllowedMsgTypeUrls[i] {
+			return false
+		}
+	}
+	if len(this.DeniedMsgTypeUrls) != len(that1.DeniedMsgTypeUrls) {
+		return false
+	}
+	for i := range this.DeniedMsgTypeUrls {
+		if this.DeniedMsgTypeUrls[i] != that1.DeniedMsgTypeUrls[i] {
+			return false
+		}
+	}
+	if !this.MinGasPrice.Equal(&that1.MinGasPrice) {
+		return false
+	}
+	if len(this.MsgGasPriceMultipliers) != len(that1.MsgGasPriceMultipliers) {
+		return false
+	}
+	for i := range this.MsgGasPriceMultipliers {
+		if !this.MsgGasPriceMultipliers[i].Equal(&that1.MsgGasPriceMultipliers[i]) {
+			return false
+		}
+	}
+	if this.CouncilFeeExemption != that1.CouncilFeeExemption {
+		return false
+	}
+	return true
+}
+func (this *MsgGasPriceMultiplier) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgGasPriceMultiplier)
+	if !ok {
+		that2, ok := that.(MsgGasPriceMultiplier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MsgTypeUrl != that1.MsgTypeUrl {
+		return false
+	}
+	if !this.Multiplier.Equal(that1.Multiplier) {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CouncilFeeExemption {
+		i--
+		if m.CouncilFeeExemption {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MsgGasPriceMultipliers) > 0 {
+		for iNdEx := len(m.MsgGasPriceMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgGasPriceMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.MinGasPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DeniedMsgTypeUrls) > 0 {
+		for iNdEx := len(m.DeniedMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.DeniedMsgTypeUrls[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.DeniedMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedMsgTypeUrls) > 0 {
+		for iNdEx := len(m.AllowedMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.AllowedMsgTypeUrls[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGasPriceMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGasPriceMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGasPriceMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedMsgTypeUrls) > 0 {
+		for _, s := range m.AllowedMsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.DeniedMsgTypeUrls) > 0 {
+		for _, s := range m.DeniedMsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.MsgGasPriceMultipliers) > 0 {
+		for _, e := range m.MsgGasPriceMultipliers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.CouncilFeeExemption {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgGasPriceMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMsgTypeUrls = append(m.AllowedMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedMsgTypeUrls = append(m.DeniedMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgGasPriceMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgGasPriceMultipliers = append(m.MsgGasPriceMultipliers, MsgGasPriceMultiplier{})
+			if err := m.MsgGasPriceMultipliers[len(m.MsgGasPriceMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CouncilFeeExemption", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CouncilFeeExemption = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGasPriceMultiplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGasPriceMultiplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGasPriceMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,164 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"veranatest/x/txpolicy/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	tests := []struct {
+		desc   string
+		params types.Params
+		expErr error
+	}{
+		{
+			desc:   "default is valid",
+			params: types.DefaultParams(),
+		},
+		{
+			desc:   "deny list",
+			params: msgTypeParams(nil, []string{"/cosmos.gov.v1.MsgSubmitProposal", "/cosmos.nft.v1beta1.MsgSend"}),
+		},
+		{
+			desc:   "allow list including council messages",
+			params: msgTypeParams(append([]string{"/cosmos.bank.v1beta1.MsgSend"}, types.CouncilMsgTypeURLs()...), nil),
+		},
+		{
+			desc:   "missing leading slash",
+			params: msgTypeParams(nil, []string{"cosmos.gov.v1.MsgSubmitProposal"}),
+			expErr: types.ErrInvalidMsgTypeURL,
+		},
+		{
+			desc:   "duplicate entry",
+			params: msgTypeParams(nil, []string{"/cosmos.nft.v1beta1.MsgSend", "/cosmos.nft.v1beta1.MsgSend"}),
+			expErr: types.ErrInvalidMsgTypeURL,
+		},
+		{
+			desc:   "allowed and denied",
+			params: msgTypeParams(append([]string{"/cosmos.nft.v1beta1.MsgSend"}, types.CouncilMsgTypeURLs()...), []string{"/cosmos.nft.v1beta1.MsgSend"}),
+			expErr: types.ErrInvalidMsgTypeURL,
+		},
+		{
+			desc:   "council message denied",
+			params: msgTypeParams(nil, []string{"/veranatest.txpolicy.v1.MsgUpdateParams"}),
+			expErr: types.ErrMsgTypeDenied,
+		},
+		{
+			desc:   "allow list missing council messages",
+			params: msgTypeParams([]string{"/cosmos.bank.v1beta1.MsgSend"}, nil),
+			expErr: types.ErrMsgTypeNotAllowed,
+		},
+		{
+			desc:   "unset min gas price",
+			params: feeParams(sdk.DecCoin{}),
+		},
+		{
+			desc:   "invalid min gas price denom",
+			params: feeParams(sdk.DecCoin{Denom: "1uvna", Amount: math.LegacyOneDec()}),
+			expErr: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			desc: "gas price multipliers",
+			params: feeParams(sdk.NewDecCoin(types.DefaultFeeDenom, math.OneInt()),
+				types.MsgGasPriceMultiplier{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Multiplier: math.LegacyZeroDec()},
+				types.MsgGasPriceMultiplier{MsgTypeUrl: "/cosmos.gov.v1.MsgSubmitProposal", Multiplier: math.LegacyNewDec(10)},
+			),
+		},
+		{
+			desc: "negative gas price multiplier",
+			params: feeParams(sdk.NewDecCoin(types.DefaultFeeDenom, math.OneInt()),
+				types.MsgGasPriceMultiplier{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Multiplier: math.LegacyNewDec(-1)},
+			),
+			expErr: types.ErrInvalidGasPriceMultiplier,
+		},
+		{
+			desc: "nil gas price multiplier",
+			params: feeParams(sdk.NewDecCoin(types.DefaultFeeDenom, math.OneInt()),
+				types.MsgGasPriceMultiplier{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend"},
+			),
+			expErr: types.ErrInvalidGasPriceMultiplier,
+		},
+		{
+			desc: "duplicate gas price multiplier",
+			params: feeParams(sdk.NewDecCoin(types.DefaultFeeDenom, math.OneInt()),
+				types.MsgGasPriceMultiplier{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Multiplier: math.LegacyOneDec()},
+				types.MsgGasPriceMultiplier{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Multiplier: math.LegacyNewDec(2)},
+			),
+			expErr: types.ErrInvalidMsgTypeURL,
+		},
+		{
+			desc: "gas price multiplier with invalid url",
+			params: feeParams(sdk.NewDecCoin(types.DefaultFeeDenom, math.OneInt()),
+				types.MsgGasPriceMultiplier{MsgTypeUrl: "cosmos.bank.v1beta1.MsgSend", Multiplier: math.LegacyOneDec()},
+			),
+			expErr: types.ErrInvalidMsgTypeURL,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestParamsCheckMsgTypeURL(t *testing.T) {
+	const (
+		send     = "/cosmos.bank.v1beta1.MsgSend"
+		proposal = "/cosmos.gov.v1.MsgSubmitProposal"
+	)
+
+	require.NoError(t, types.DefaultParams().CheckMsgTypeURL(send))
+	require.ErrorIs(t, types.DefaultParams().CheckMsgTypeURL(proposal), types.ErrMsgTypeDenied)
+	require.ErrorIs(t, types.DefaultParams().CheckMsgTypeURL("/cosmos.gov.v1beta1.MsgSubmitProposal"), types.ErrMsgTypeDenied)
+	require.ErrorIs(t, types.DefaultParams().CheckMsgTypeURL("/cosmos.nft.v1beta1.MsgSend"), types.ErrMsgTypeDenied)
+
+	denied := msgTypeParams(nil, []string{proposal})
+	require.NoError(t, denied.CheckMsgTypeURL(send))
+	err := denied.CheckMsgTypeURL(proposal)
+	require.ErrorIs(t, err, types.ErrMsgTypeDenied)
+	require.ErrorContains(t, err, proposal)
+
+	allowed := msgTypeParams([]string{send}, nil)
+	require.NoError(t, allowed.CheckMsgTypeURL(send))
+	err = allowed.CheckMsgTypeURL(proposal)
+	require.ErrorIs(t, err, types.ErrMsgTypeNotAllowed)
+	require.ErrorContains(t, err, proposal)
+}
+
+func TestParamsGasPriceMultiplier(t *testing.T) {
+	params := feeParams(sdk.NewDecCoin(types.DefaultFeeDenom, math.OneInt()),
+		types.MsgGasPriceMultiplier{MsgTypeUrl: "/cosmos.gov.v1.MsgSubmitProposal", Multiplier: math.LegacyNewDec(10)},
+	)
+
+	require.Equal(t, math.LegacyNewDec(10), params.GasPriceMultiplier("/cosmos.gov.v1.MsgSubmitProposal"))
+	require.Equal(t, math.LegacyOneDec(), params.GasPriceMultiplier("/cosmos.bank.v1beta1.MsgSend"))
+}
+
+// msgTypeParams returns the default params with the given allowed and denied
+// message type URLs.
+func msgTypeParams(allowed, denied []string) types.Params {
+	params := types.DefaultParams()
+	params.AllowedMsgTypeUrls = allowed
+	params.DeniedMsgTypeUrls = denied
+	return params
+}
+
+// feeParams returns the default params with the given consensus minimum gas
+// price and gas price multipliers.
+func feeParams(minGasPrice sdk.DecCoin, multipliers ...types.MsgGasPriceMultiplier) types.Params {
+	params := types.DefaultParams()
+	params.MinGasPrice = minGasPrice
+	params.MsgGasPriceMultipliers = multipliers
+	return params
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"veranatest/x/validatorregistry/types"
+)
+
+const (
+	bondedValidatorsRoute = "bonded-validators"
+	validatorIndexRoute   = "validator-index"
+)
+
+// invariantRoute is an invariant and the crisis route it is registered under.
+type invariantRoute struct {
+	route     string
+	invariant func(Keeper) sdk.Invariant
+}
+
+var invariantRoutes = []invariantRoute{
+	{route: bondedValidatorsRoute, invariant: BondedValidatorsInvariant},
+	{route: validatorIndexRoute, invariant: ValidatorIndexInvariant},
+}
+
+// RegisterInvariants registers the validatorregistry module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	for _, r := range invariantRoutes {
+		ir.RegisterRoute(types.ModuleName, r.route, r.invariant(k))
+	}
+}
+
+// RunInvariants runs the validatorregistry module invariants in a cached
+// context, so they write nothing.
+func (k Keeper) RunInvariants(ctx sdk.Context) []types.InvariantResult {
+	results := make([]types.InvariantResult, 0, len(invariantRoutes))
+	for _, r := range invariantRoutes {
+		cacheCtx, _ := ctx.CacheContext()
+		msg, broken := r.invariant(k)(cacheCtx)
+		results = append(results, types.InvariantResult{Route: r.route, Broken: broken, Message: msg})
+	}
+
+	return results
+}
+
+// BondedValidatorsInvariant checks that every bonded staking validator has an
+// active registry entry.
+func BondedValidatorsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		active := make(map[string]bool)
+		err := k.Validator.Walk(ctx, nil, func(_ string, val types.Validator) (bool, error) {
+			if strings.EqualFold(val.Status, types.ValidatorStatusActive) {
+				active[val.OperatorAddress] = true
+			}
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, bondedValidatorsRoute, err.Error()), true
+		}
+
+		bonded, err := k.stakingKeeper.GetBondedValidatorsByPower(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, bondedValidatorsRoute, err.Error()), true
+		}
+
+		var msg strings.Builder
+		broken := false
+		for _, val := range bonded {
+			if !active[val.OperatorAddress] {
+				broken = true
+				fmt.Fprintf(&msg, "\tbonded validator %s has no active registry entry\n", val.OperatorAddress)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, bondedValidatorsRoute, fmt.Sprintf(
+			"\tbonded validators: %d\n\tactive registry entries: %d\n%s", len(bonded), len(active), msg.String(),
+		)), broken
+	}
+}
+
+// ValidatorIndexInvariant checks that every registry entry is stored under its
+// index, and that no two entries share an operator address, which
+// IsValidatorWhitelisted looks entries up by.
+func ValidatorIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg strings.Builder
+		broken := false
+		operators := make(map[string]string)
+		err := k.Validator.Walk(ctx, nil, func(key string, val types.Validator) (bool, error) {
+			if key != val.Index {
+				broken = true
+				fmt.Fprintf(&msg, "\tregistry entry %s is stored under index %s\n", val.Index, key)
+			}
+			if other, ok := operators[val.OperatorAddress]; ok {
+				broken = true
+				fmt.Fprintf(&msg, "\tregistry entries %s and %s share operator address %s\n", other, key, val.OperatorAddress)
+			}
+			operators[val.OperatorAddress] = key
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, validatorIndexRoute, err.Error()), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, validatorIndexRoute, fmt.Sprintf(
+			"\tregistry entries: %d\n%s", len(operators), msg.String(),
+		)), broken
+	}
+}
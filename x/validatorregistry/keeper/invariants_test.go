@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"veranatest/x/validatorregistry/keeper"
+	"veranatest/x/validatorregistry/types"
+)
+
+func TestBondedValidatorsInvariant(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	invariant := keeper.BondedValidatorsInvariant(f.keeper)
+
+	require.NoError(t, f.keeper.Validator.Set(ctx, "val1", types.Validator{Index: "val1", OperatorAddress: "valoper1", Status: "active"}))
+	require.NoError(t, f.keeper.Validator.Set(ctx, "val2", types.Validator{Index: "val2", OperatorAddress: "valoper2", Status: "suspended"}))
+
+	// an active entry does not have to be bonded
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	f.stakingKeeper.bonded = []stakingtypes.Validator{{OperatorAddress: "valoper1"}}
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// a bonded validator with a suspended entry
+	f.stakingKeeper.bonded = append(f.stakingKeeper.bonded, stakingtypes.Validator{OperatorAddress: "valoper2"})
+	msg, broken := invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "valoper2")
+
+	// a bonded validator without entry
+	f.stakingKeeper.bonded = []stakingtypes.Validator{{OperatorAddress: "valoper3"}}
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+
+	// statuses are compared case-insensitively
+	require.NoError(t, f.keeper.Validator.Set(ctx, "val3", types.Validator{Index: "val3", OperatorAddress: "valoper3", Status: "ACTIVE"}))
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+}
+
+func TestValidatorIndexInvariant(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	invariant := keeper.ValidatorIndexInvariant(f.keeper)
+
+	createNValidator(f.keeper, ctx, 3)
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// an entry stored under another index
+	require.NoError(t, f.keeper.Validator.Set(ctx, "3", types.Validator{Index: "4", OperatorAddress: "4"}))
+	msg, broken := invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "stored under index 3")
+	require.NoError(t, f.keeper.Validator.Remove(ctx, "3"))
+
+	// two entries sharing an operator address
+	require.NoError(t, f.keeper.Validator.Set(ctx, "3", types.Validator{Index: "3", OperatorAddress: "0"}))
+	msg, broken = invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "share operator address 0")
+}
+
+func TestQueryInvariants(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	f.stakingKeeper.bonded = []stakingtypes.Validator{{OperatorAddress: "valoper1"}}
+
+	response, err := qs.Invariants(f.ctx, &types.QueryInvariantsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"bonded-validators", "validator-index"}, []string{response.Invariants[0].Route, response.Invariants[1].Route})
+	require.True(t, response.Invariants[0].Broken)
+	require.False(t, response.Invariants[1].Broken)
+
+	_, err = qs.Invariants(f.ctx, nil)
+	require.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"fmt"
+	"strings"
 	"veranatest/x/validatorregistry/types"
 
 	"cosmossdk.io/collections"
@@ -25,6 +26,9 @@ type Keeper struct {
 	Schema    collections.Schema
 	Params    collections.Item[types.Params]
 	Validator collections.Map[string, types.Validator]
+
+	stakingKeeper types.StakingKeeper
+	tdKeeper      types.TdKeeper
 }
 
 func NewKeeper(
@@ -33,7 +37,8 @@ func NewKeeper(
 	addressCodec address.Codec,
 	authority []byte,
 	logger log.Logger,
-
+	stakingKeeper types.StakingKeeper,
+	tdKeeper types.TdKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -48,7 +53,11 @@ func NewKeeper(
 		authority:    authority,
 		logger:       logger,
 		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		Validator:    collections.NewMap(sb, types.ValidatorKey, "validator", collections.StringKey, codec.CollValue[types.Validator](cdc))}
+		Validator:    collections.NewMap(sb, types.ValidatorKey, "validator", collections.StringKey, codec.CollValue[types.Validator](cdc)),
+
+		stakingKeeper: stakingKeeper,
+		tdKeeper:      tdKeeper,
+	}
 
 	schema, err := sb.Build()
 	if err != nil {
@@ -68,17 +77,32 @@ func (k Keeper) Logger() log.Logger {
 	return k.logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-// IsValidatorWhitelisted checks if a validator operator address is whitelisted
-// This method is used by the ante handler to verify if a validator can create a validator
+// IsValidatorWhitelisted checks if a validator operator address has an active
+// registry entry. This method is used by the ante handler to verify if a
+// validator can create a validator, so only validators allowed to be bonded
+// are whitelisted.
 func (k Keeper) IsValidatorWhitelisted(ctx context.Context, operatorAddress string) bool {
+	val, found, err := k.getValidatorByOperator(ctx, operatorAddress)
+	if err != nil || !found {
+		return false
+	}
+	return strings.EqualFold(val.Status, types.ValidatorStatusActive)
+}
+
+// getValidatorByOperator returns the registry entry with the given operator
+// address, if any.
+func (k Keeper) getValidatorByOperator(ctx context.Context, operatorAddress string) (types.Validator, bool, error) {
 	// Walk through all validators in the store and check if the operator address matches
-	var found bool
-	_ = k.Validator.Walk(ctx, nil, func(key string, val types.Validator) (stop bool, err error) {
+	var (
+		validator types.Validator
+		found     bool
+	)
+	err := k.Validator.Walk(ctx, nil, func(key string, val types.Validator) (stop bool, err error) {
 		if val.OperatorAddress == operatorAddress {
-			found = true
+			validator, found = val, true
 			return true, nil // stop iteration when found
 		}
 		return false, nil // continue iteration
 	})
-	return found
+	return validator, found, err
 }
@@ -2,9 +2,11 @@ package keeper_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -12,6 +14,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"veranatest/x/validatorregistry/keeper"
 	module "veranatest/x/validatorregistry/module"
@@ -19,9 +22,11 @@ import (
 )
 
 type fixture struct {
-	ctx          context.Context
-	keeper       keeper.Keeper
-	addressCodec address.Codec
+	ctx           context.Context
+	keeper        keeper.Keeper
+	addressCodec  address.Codec
+	stakingKeeper *mockStakingKeeper
+	tdKeeper      *mockTdKeeper
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -35,12 +40,17 @@ func initFixture(t *testing.T) *fixture {
 	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	stakingKeeper := &mockStakingKeeper{validators: make(map[string]stakingtypes.Validator)}
+	tdKeeper := &mockTdKeeper{deposits: make(map[string]uint64), locked: make(map[string]uint64)}
 
 	k := keeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
 		addressCodec,
 		authority,
+		log.NewNopLogger(),
+		stakingKeeper,
+		tdKeeper,
 	)
 
 	// Initialize params
@@ -49,8 +59,68 @@ func initFixture(t *testing.T) *fixture {
 	}
 
 	return &fixture{
-		ctx:          ctx,
-		keeper:       k,
-		addressCodec: addressCodec,
+		ctx:           ctx,
+		keeper:        k,
+		addressCodec:  addressCodec,
+		stakingKeeper: stakingKeeper,
+		tdKeeper:      tdKeeper,
 	}
 }
+
+// mockStakingKeeper serves the given bonded validators and the given
+// validators by operator address, and jails validators in place.
+type mockStakingKeeper struct {
+	bonded     []stakingtypes.Validator
+	validators map[string]stakingtypes.Validator
+}
+
+func (m *mockStakingKeeper) GetBondedValidatorsByPower(context.Context) ([]stakingtypes.Validator, error) {
+	return m.bonded, nil
+}
+
+func (m *mockStakingKeeper) GetValidator(_ context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error) {
+	val, ok := m.validators[addr.String()]
+	if !ok {
+		return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+	}
+	return val, nil
+}
+
+func (m *mockStakingKeeper) Jail(_ context.Context, consAddr sdk.ConsAddress) error {
+	for operator, val := range m.validators {
+		valConsAddr, err := val.GetConsAddr()
+		if err != nil {
+			return err
+		}
+		if consAddr.Equals(sdk.ConsAddress(valConsAddr)) {
+			val.Jailed = true
+			m.validators[operator] = val
+			return nil
+		}
+	}
+	return stakingtypes.ErrNoValidatorFound
+}
+
+func (m *mockStakingKeeper) BondDenom(context.Context) (string, error) {
+	return sdk.DefaultBondDenom, nil
+}
+
+// mockTdKeeper holds the trust deposit of each account and the part of it
+// locked.
+type mockTdKeeper struct {
+	deposits map[string]uint64
+	locked   map[string]uint64
+}
+
+func (m *mockTdKeeper) LockTrustDeposit(_ context.Context, account sdk.AccAddress, amount uint64) error {
+	if amount > m.deposits[account.String()]-m.locked[account.String()] {
+		return errors.New("insufficient trust deposit")
+	}
+	m.locked[account.String()] += amount
+	return nil
+}
+
+func (m *mockTdKeeper) UnlockTrustDeposit(_ context.Context, account sdk.AccAddress, amount uint64) error {
+	m.locked[account.String()] -= min(amount, m.locked[account.String()])
+	return nil
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"veranatest/x/validatorregistry/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (k msgServer) OffboardValidator(ctx context.Context, msg *types.MsgOffboardValidator) (*types.MsgOffboardValidatorResponse, error) {
+	// Validate creator address format
+	creatorAddr, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid creator address")
+	}
+
+	// Only the module authority (the council group policy) can offboard validators
+	authority := k.GetAuthority()
+	if !sdk.AccAddress(creatorAddr).Equals(sdk.AccAddress(authority)) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner,
+			"expected authority %s, got %s",
+			sdk.AccAddress(authority).String(),
+			msg.Creator,
+		)
+	}
+
+	validator, err := k.Validator.Get(ctx, msg.Index)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrValidatorNotFound, "index %s", msg.Index)
+		}
+		return nil, errorsmod.Wrap(err, "failed to get validator")
+	}
+
+	// A bonded validator must keep its registry entry, see BondedValidatorsInvariant
+	bonded, err := k.stakingKeeper.GetBondedValidatorsByPower(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get bonded validators")
+	}
+	for _, val := range bonded {
+		if val.OperatorAddress == validator.OperatorAddress {
+			return nil, errorsmod.Wrapf(types.ErrValidatorBonded, "%s must unbond before it is offboarded", validator.OperatorAddress)
+		}
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validator.OperatorAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidValidator, "invalid operator address format: %v", err)
+	}
+
+	// An unbonded validator can still be bonded again by rank, so jail it before
+	// the lock is released. It can only be unjailed once onboarded again.
+	stakingValidator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	switch {
+	case errors.Is(err, stakingtypes.ErrNoValidatorFound):
+	case err != nil:
+		return nil, errorsmod.Wrap(err, "failed to get staking validator")
+	case !stakingValidator.IsJailed():
+		consAddr, err := stakingValidator.GetConsAddr()
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to get consensus address")
+		}
+		if err := k.stakingKeeper.Jail(ctx, consAddr); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to jail validator")
+		}
+	}
+
+	// Release the trust deposit locked when the validator was onboarded
+	if validator.LockedTrustDeposit > 0 {
+		if err := k.tdKeeper.UnlockTrustDeposit(ctx, sdk.AccAddress(valAddr), validator.LockedTrustDeposit); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to unlock trust deposit")
+		}
+	}
+
+	if err := k.Validator.Remove(ctx, msg.Index); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to remove validator")
+	}
+
+	// Emit event for validator offboarding
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"validator_offboarded",
+			sdk.NewAttribute("index", validator.Index),
+			sdk.NewAttribute("operator_address", validator.OperatorAddress),
+		),
+	)
+
+	return &types.MsgOffboardValidatorResponse{}, nil
+}
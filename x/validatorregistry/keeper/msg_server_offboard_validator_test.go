@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"veranatest/testutil/sample"
+	"veranatest/x/validatorregistry/keeper"
+	"veranatest/x/validatorregistry/types"
+)
+
+func TestOffboardValidator(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.Params{MinTrustDeposit: 1000}))
+
+	msg, account := onboardMsg(t, f, "1")
+	f.tdKeeper.deposits[account.String()] = 1000
+	_, err := ms.OnboardValidator(f.ctx, msg)
+	require.NoError(t, err)
+
+	_, err = ms.OffboardValidator(f.ctx, &types.MsgOffboardValidator{Creator: sample.AccAddress(), Index: "1"})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.OffboardValidator(f.ctx, &types.MsgOffboardValidator{Creator: msg.Creator, Index: "2"})
+	require.ErrorIs(t, err, types.ErrValidatorNotFound)
+
+	// a bonded validator must unbond first
+	f.stakingKeeper.bonded = []stakingtypes.Validator{{OperatorAddress: msg.OperatorAddress}}
+	_, err = ms.OffboardValidator(f.ctx, &types.MsgOffboardValidator{Creator: msg.Creator, Index: "1"})
+	require.ErrorIs(t, err, types.ErrValidatorBonded)
+	require.Equal(t, uint64(1000), f.tdKeeper.locked[account.String()])
+
+	// the lock taken at onboarding is released, even if the param changed since
+	f.stakingKeeper.bonded = nil
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.Params{MinTrustDeposit: 5000}))
+	_, err = ms.OffboardValidator(f.ctx, &types.MsgOffboardValidator{Creator: msg.Creator, Index: "1"})
+	require.NoError(t, err)
+	require.Zero(t, f.tdKeeper.locked[account.String()])
+	require.False(t, f.keeper.IsValidatorWhitelisted(f.ctx, msg.OperatorAddress))
+}
+
+func TestOffboardValidatorJailsStakingValidator(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	msg, _ := onboardMsg(t, f, "1")
+	_, err := ms.OnboardValidator(f.ctx, msg)
+	require.NoError(t, err)
+
+	// an unbonded staking validator could be bonded again by rank
+	val, err := stakingtypes.NewValidator(msg.OperatorAddress, ed25519.GenPrivKey().PubKey(), stakingtypes.Description{})
+	require.NoError(t, err)
+	f.stakingKeeper.validators[msg.OperatorAddress] = val
+
+	_, err = ms.OffboardValidator(f.ctx, &types.MsgOffboardValidator{Creator: msg.Creator, Index: "1"})
+	require.NoError(t, err)
+	require.True(t, f.stakingKeeper.validators[msg.OperatorAddress].IsJailed())
+
+	// an already jailed validator is offboarded as is
+	_, err = ms.OnboardValidator(f.ctx, msg)
+	require.NoError(t, err)
+	_, err = ms.OffboardValidator(f.ctx, &types.MsgOffboardValidator{Creator: msg.Creator, Index: "1"})
+	require.NoError(t, err)
+	require.True(t, f.stakingKeeper.validators[msg.OperatorAddress].IsJailed())
+}
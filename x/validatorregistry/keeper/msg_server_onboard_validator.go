@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"slices"
+	"strings"
 
 	"veranatest/x/validatorregistry/types"
 
@@ -40,6 +42,11 @@ func (k msgServer) OnboardValidator(ctx context.Context, msg *types.MsgOnboardVa
 	if msg.Status == "" {
 		return nil, errorsmod.Wrap(types.ErrInvalidValidator, "status cannot be empty")
 	}
+	if !slices.ContainsFunc(types.ValidatorStatuses(), func(status string) bool {
+		return strings.EqualFold(msg.Status, status)
+	}) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidValidator, "status %q must be one of %v", msg.Status, types.ValidatorStatuses())
+	}
 
 	// Check if validator with this index already exists
 	exists, err := k.Validator.Has(ctx, msg.Index)
@@ -50,20 +57,43 @@ func (k msgServer) OnboardValidator(ctx context.Context, msg *types.MsgOnboardVa
 		return nil, errorsmod.Wrapf(types.ErrInvalidValidator, "validator with index %s already exists", msg.Index)
 	}
 
+	// An operator address has at most one registry entry, which the whitelist
+	// and the invariants look up
+	existing, found, err := k.getValidatorByOperator(ctx, msg.OperatorAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to check operator address")
+	}
+	if found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidValidator,
+			"operator address %s is already onboarded with index %s", msg.OperatorAddress, existing.Index)
+	}
+
 	// Validate operator address format (should be cosmosvaloper...)
-	_, err = sdk.ValAddressFromBech32(msg.OperatorAddress)
+	valAddr, err := sdk.ValAddressFromBech32(msg.OperatorAddress)
 	if err != nil {
 		return nil, errorsmod.Wrapf(types.ErrInvalidValidator, "invalid operator address format: %v", err)
 	}
 
+	// Lock the trust deposit the operator account must have in x/td
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get params")
+	}
+	if params.MinTrustDeposit > 0 {
+		if err := k.tdKeeper.LockTrustDeposit(ctx, sdk.AccAddress(valAddr), params.MinTrustDeposit); err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInsufficientTrustDeposit, "operator account of %s: %v", msg.OperatorAddress, err)
+		}
+	}
+
 	// Create the Validator object
 	validator := types.Validator{
-		Index:           msg.Index,
-		MemberId:        msg.MemberId,
-		OperatorAddress: msg.OperatorAddress,
-		ConsensusPubkey: msg.ConsensusPubkey,
-		Status:          msg.Status,
-		TermEnd:         msg.TermEnd,
+		Index:              msg.Index,
+		MemberId:           msg.MemberId,
+		OperatorAddress:    msg.OperatorAddress,
+		ConsensusPubkey:    msg.ConsensusPubkey,
+		Status:             msg.Status,
+		TermEnd:            msg.TermEnd,
+		LockedTrustDeposit: params.MinTrustDeposit,
 	}
 
 	// Store the validator in the KV store
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"veranatest/testutil/sample"
+	"veranatest/x/validatorregistry/keeper"
+	"veranatest/x/validatorregistry/types"
+)
+
+// onboardMsg returns a MsgOnboardValidator of the module authority for a new
+// operator, and the operator account.
+func onboardMsg(t *testing.T, f *fixture, index string) (*types.MsgOnboardValidator, sdk.AccAddress) {
+	t.Helper()
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	account := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	return &types.MsgOnboardValidator{
+		Creator:         authority,
+		Index:           index,
+		MemberId:        "member-" + index,
+		OperatorAddress: sdk.ValAddress(account).String(),
+		Status:          types.ValidatorStatusActive,
+	}, account
+}
+
+func TestOnboardValidatorTrustDeposit(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.Params{MinTrustDeposit: 1000}))
+
+	// the operator account has too small a trust deposit
+	msg, account := onboardMsg(t, f, "1")
+	f.tdKeeper.deposits[account.String()] = 999
+	_, err := ms.OnboardValidator(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrInsufficientTrustDeposit)
+	has, err := f.keeper.Validator.Has(f.ctx, "1")
+	require.NoError(t, err)
+	require.False(t, has)
+
+	f.tdKeeper.deposits[account.String()] = 1500
+	_, err = ms.OnboardValidator(f.ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), f.tdKeeper.locked[account.String()])
+
+	validator, err := f.keeper.Validator.Get(f.ctx, "1")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), validator.LockedTrustDeposit)
+}
+
+func TestOnboardValidatorWithoutTrustDeposit(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	// the default params require no trust deposit
+	msg, account := onboardMsg(t, f, "1")
+	_, err := ms.OnboardValidator(f.ctx, msg)
+	require.NoError(t, err)
+	require.Zero(t, f.tdKeeper.locked[account.String()])
+}
+
+func TestOnboardValidatorStatus(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	msg, _ := onboardMsg(t, f, "1")
+	msg.Status = "bonded"
+	_, err := ms.OnboardValidator(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidValidator)
+	require.ErrorContains(t, err, `status "bonded" must be one of`)
+
+	// statuses are compared case-insensitively
+	msg.Status = "Suspended"
+	_, err = ms.OnboardValidator(f.ctx, msg)
+	require.NoError(t, err)
+
+	// only active entries are whitelisted
+	require.False(t, f.keeper.IsValidatorWhitelisted(f.ctx, msg.OperatorAddress))
+	msg, _ = onboardMsg(t, f, "2")
+	msg.Status = "ACTIVE"
+	_, err = ms.OnboardValidator(f.ctx, msg)
+	require.NoError(t, err)
+	require.True(t, f.keeper.IsValidatorWhitelisted(f.ctx, msg.OperatorAddress))
+}
+
+func TestOnboardValidatorDuplicateOperator(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	msg, _ := onboardMsg(t, f, "1")
+	_, err := ms.OnboardValidator(f.ctx, msg)
+	require.NoError(t, err)
+
+	msg.Index = "2"
+	_, err = ms.OnboardValidator(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidValidator)
+	require.ErrorContains(t, err, "is already onboarded with index 1")
+	has, err := f.keeper.Validator.Has(f.ctx, "2")
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -29,6 +29,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Alias:          []string{"show-validator"},
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
 				},
+				{
+					RpcMethod: "Invariants",
+					Use:       "invariants",
+					Short:     "Runs the validatorregistry module invariants and shows which are broken",
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -46,6 +51,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Send a onboard-validator tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "member_id"}, {ProtoField: "operator_address"}, {ProtoField: "consensus_pubkey"}, {ProtoField: "status"}, {ProtoField: "term_end"}},
 				},
+				{
+					RpcMethod:      "OffboardValidator",
+					Use:            "offboard-validator [index]",
+					Short:          "Send a offboard-validator tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
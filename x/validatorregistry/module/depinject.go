@@ -29,14 +29,16 @@ func init() {
 type ModuleInputs struct {
 	depinject.In
 
-	Config       *types.Module
-	StoreService store.KVStoreService
-	Cdc          codec.Codec
-	AddressCodec address.Codec
-	Logger       log.Logger
-	AuthKeeper   types.AuthKeeper
-	BankKeeper   types.BankKeeper
-	GroupKeeper  types.GroupKeeper
+	Config        *types.Module
+	StoreService  store.KVStoreService
+	Cdc           codec.Codec
+	AddressCodec  address.Codec
+	Logger        log.Logger
+	AuthKeeper    types.AuthKeeper
+	BankKeeper    types.BankKeeper
+	GroupKeeper   types.GroupKeeper
+	StakingKeeper types.StakingKeeper
+	TdKeeper      types.TdKeeper
 }
 
 type ModuleOutputs struct {
@@ -58,8 +60,10 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.AddressCodec,
 		authority,
 		in.Logger,
+		in.StakingKeeper,
+		in.TdKeeper,
 	)
-	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper, in.GroupKeeper)
+	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper, in.GroupKeeper, in.StakingKeeper)
 
 	return ModuleOutputs{ValidatorregistryKeeper: k, Module: m}
 }
@@ -23,6 +23,7 @@ var (
 	_ module.AppModuleBasic = (*AppModule)(nil)
 	_ module.AppModule      = (*AppModule)(nil)
 	_ module.HasGenesis     = (*AppModule)(nil)
+	_ module.HasInvariants  = (*AppModule)(nil)
 
 	_ appmodule.AppModule       = (*AppModule)(nil)
 	_ appmodule.HasBeginBlocker = (*AppModule)(nil)
@@ -31,11 +32,12 @@ var (
 
 // AppModule implements the AppModule interface that defines the inter-dependent methods that modules need to implement
 type AppModule struct {
-	cdc         codec.Codec
-	keeper      keeper.Keeper
-	authKeeper  types.AuthKeeper
-	bankKeeper  types.BankKeeper
-	groupKeeper types.GroupKeeper // Add group keeper for auto-execution
+	cdc           codec.Codec
+	keeper        keeper.Keeper
+	authKeeper    types.AuthKeeper
+	bankKeeper    types.BankKeeper
+	groupKeeper   types.GroupKeeper   // Add group keeper for auto-execution
+	stakingKeeper types.StakingKeeper // only used for simulation
 }
 
 func NewAppModule(
@@ -44,13 +46,15 @@ func NewAppModule(
 	authKeeper types.AuthKeeper,
 	bankKeeper types.BankKeeper,
 	groupKeeper types.GroupKeeper,
+	stakingKeeper types.StakingKeeper,
 ) AppModule {
 	return AppModule{
-		cdc:         cdc,
-		keeper:      keeper,
-		authKeeper:  authKeeper,
-		bankKeeper:  bankKeeper,
-		groupKeeper: groupKeeper,
+		cdc:           cdc,
+		keeper:        keeper,
+		authKeeper:    authKeeper,
+		bankKeeper:    bankKeeper,
+		groupKeeper:   groupKeeper,
+		stakingKeeper: stakingKeeper,
 	}
 }
 
@@ -129,6 +133,11 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMe
 	return bz
 }
 
+// RegisterInvariants registers the validatorregistry module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
+
 // ConsensusVersion is a sequence number for state-breaking change of the module.
 // It should be incremented on each consensus-breaking change introduced by the module.
 // To avoid wrong/empty versions, the initial version should be set to 1.
@@ -2,17 +2,28 @@ package validatorregistry
 
 import (
 	"math/rand"
+	"strconv"
+	"time"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/group"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	validatorregistrysimulation "veranatest/x/validatorregistry/simulation"
 	"veranatest/x/validatorregistry/types"
 )
 
+// councilVotingPeriod is the voting period of the simulated council. Blocks are
+// 5000s to 10000s apart, so votes cast in the block after a proposal are in
+// time, and the EndBlocker executes the proposal a few blocks later.
+const councilVotingPeriod = 24 * time.Hour
+
 // GenerateGenesisState creates a randomized GenState of the module.
-func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+func (am AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	accs := make([]string, len(simState.Accounts))
 	for i, acc := range simState.Accounts {
 		accs[i] = acc.Address.String()
@@ -20,7 +31,78 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	validatorregistryGenesis := types.GenesisState{
 		Params: types.DefaultParams(),
 	}
+
+	// The staking genesis is generated first, as modules generate their genesis
+	// in name order. Its validators are bonded, so they get active entries.
+	if bz, ok := simState.GenState[stakingtypes.ModuleName]; ok {
+		var stakingGenesis stakingtypes.GenesisState
+		simState.Cdc.MustUnmarshalJSON(bz, &stakingGenesis)
+		for _, val := range stakingGenesis.Validators {
+			validatorregistryGenesis.ValidatorMap = append(validatorregistryGenesis.ValidatorMap, types.Validator{
+				Index:           val.OperatorAddress,
+				MemberId:        val.OperatorAddress,
+				OperatorAddress: val.OperatorAddress,
+				Status:          types.ValidatorStatusActive,
+			})
+		}
+	}
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&validatorregistryGenesis)
+
+	am.generateCouncil(simState)
+}
+
+// generateCouncil adds the council to the group genesis, which is generated
+// first: a group of up to three random accounts, with the authority as its
+// group policy and a majority threshold.
+func (am AppModule) generateCouncil(simState *module.SimulationState) {
+	if len(simState.Accounts) == 0 {
+		return
+	}
+
+	groupGenesis := group.NewGenesisState()
+	if bz, ok := simState.GenState[group.ModuleName]; ok {
+		simState.Cdc.MustUnmarshalJSON(bz, groupGenesis)
+	}
+	groupGenesis.GroupSeq++
+	groupID := groupGenesis.GroupSeq
+	authority := sdk.AccAddress(am.keeper.GetAuthority()).String()
+
+	size := min(3, len(simState.Accounts))
+	for _, i := range simState.Rand.Perm(len(simState.Accounts))[:size] {
+		groupGenesis.GroupMembers = append(groupGenesis.GroupMembers, &group.GroupMember{
+			GroupId: groupID,
+			Member: &group.Member{
+				Address:  simState.Accounts[i].Address.String(),
+				Weight:   "1",
+				Metadata: simtypes.RandStringOfLength(simState.Rand, 10),
+				AddedAt:  simState.GenTimestamp,
+			},
+		})
+	}
+	groupGenesis.Groups = append(groupGenesis.Groups, &group.GroupInfo{
+		Id:          groupID,
+		Admin:       authority,
+		Metadata:    simtypes.RandStringOfLength(simState.Rand, 10),
+		Version:     1,
+		TotalWeight: strconv.Itoa(size),
+		CreatedAt:   simState.GenTimestamp,
+	})
+
+	decisionPolicy, err := codectypes.NewAnyWithValue(group.NewThresholdDecisionPolicy(strconv.Itoa(size/2+1), councilVotingPeriod, 0))
+	if err != nil {
+		panic(err)
+	}
+	groupGenesis.GroupPolicies = append(groupGenesis.GroupPolicies, &group.GroupPolicyInfo{
+		Address:        authority,
+		GroupId:        groupID,
+		Admin:          authority,
+		Metadata:       simtypes.RandStringOfLength(simState.Rand, 10),
+		Version:        1,
+		DecisionPolicy: decisionPolicy,
+		CreatedAt:      simState.GenTimestamp,
+	})
+
+	simState.GenState[group.ModuleName] = simState.Cdc.MustMarshalJSON(groupGenesis)
 }
 
 // RegisterStoreDecoder registers a decoder.
@@ -32,6 +114,8 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 	const (
 		opWeightMsgOnboardValidator          = "op_weight_msg_validatorregistry"
 		defaultWeightMsgOnboardValidator int = 100
+		opWeightMsgCreateValidator           = "op_weight_msg_validatorregistry_create_validator"
+		defaultWeightMsgCreateValidator  int = 100
 	)
 
 	var weightMsgOnboardValidator int
@@ -42,7 +126,18 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 	)
 	operations = append(operations, simulation.NewWeightedOperation(
 		weightMsgOnboardValidator,
-		validatorregistrysimulation.SimulateMsgOnboardValidator(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+		validatorregistrysimulation.SimulateMsgOnboardValidator(am.authKeeper, am.bankKeeper, am.groupKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgCreateValidator int
+	simState.AppParams.GetOrGenerate(opWeightMsgCreateValidator, &weightMsgCreateValidator, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateValidator = defaultWeightMsgCreateValidator
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreateValidator,
+		validatorregistrysimulation.SimulateMsgCreateValidator(am.authKeeper, am.bankKeeper, am.stakingKeeper, am.keeper, simState.TxConfig),
 	))
 
 	return operations
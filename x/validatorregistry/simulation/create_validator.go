@@ -0,0 +1,110 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"veranatest/x/validatorregistry/keeper"
+	"veranatest/x/validatorregistry/types"
+)
+
+// SimulateMsgCreateValidator creates the staking validator of a random account
+// whose operator is whitelisted, with a random self-delegation. Validators are
+// only created this way, as the ante handler rejects the MsgCreateValidator of
+// operators that are not onboarded.
+func SimulateMsgCreateValidator(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	sk types.StakingKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&stakingtypes.MsgCreateValidator{})
+
+		// pick a random whitelisted account that is not a validator yet
+		var (
+			simAccount simtypes.Account
+			found      bool
+		)
+		for _, i := range r.Perm(len(accs)) {
+			address := sdk.ValAddress(accs[i].Address)
+			if !k.IsValidatorWhitelisted(ctx, address.String()) {
+				continue
+			}
+			if _, err := sk.GetValidator(ctx, address); err == nil {
+				continue
+			}
+			simAccount, found = accs[i], true
+			break
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no whitelisted account without a validator"), nil, nil
+		}
+
+		denom, err := sk.BondDenom(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "bond denom not found"), nil, err
+		}
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		if !spendable.AmountOf(denom).IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no spendable bond denom"), nil, nil
+		}
+		amount, err := simtypes.RandPositiveInt(r, spendable.AmountOf(denom))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate positive amount"), nil, err
+		}
+		selfDelegation := sdk.NewCoin(denom, amount)
+
+		var fees sdk.Coins
+		if coins, hasNeg := spendable.SafeSub(selfDelegation); !hasNeg {
+			fees, err = simtypes.RandomFees(r, ctx, coins)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate fees"), nil, err
+			}
+		}
+
+		description := stakingtypes.NewDescription(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 10),
+		)
+		maxCommission := math.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 0, 100)), 2)
+		commission := stakingtypes.NewCommissionRates(
+			simtypes.RandomDecAmount(r, maxCommission),
+			maxCommission,
+			simtypes.RandomDecAmount(r, maxCommission),
+		)
+
+		msg, err := stakingtypes.NewMsgCreateValidator(
+			sdk.ValAddress(simAccount.Address).String(), simAccount.ConsKey.PubKey(), selfDelegation, description, commission, math.OneInt(),
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to build message"), nil, err
+		}
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         txGen,
+			Cdc:           nil,
+			Msg:           msg,
+			Context:       ctx,
+			SimAccount:    simAccount,
+			AccountKeeper: ak,
+			ModuleName:    types.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTx(txCtx, fees)
+	}
+}
@@ -6,27 +6,180 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"veranatest/x/validatorregistry/keeper"
 	"veranatest/x/validatorregistry/types"
 )
 
+// SimulateMsgOnboardValidator onboards the operator of a random account. The
+// authority is the council group policy, so the message is wrapped in a group
+// proposal submitted by a random council member, and every council member votes
+// yes in the next block. The EndBlocker executes the proposal once its voting
+// period ends.
 func SimulateMsgOnboardValidator(
 	ak types.AuthKeeper,
 	bk types.BankKeeper,
+	gk types.GroupKeeper,
 	k keeper.Keeper,
 	txGen client.TxConfig,
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
+		authority := sdk.AccAddress(k.GetAuthority()).String()
 		msg := &types.MsgOnboardValidator{
-			Creator: simAccount.Address.String(),
+			Creator: authority,
+			Status:  types.ValidatorStatusActive,
 		}
 
-		// TODO: Handle the OnboardValidator simulation
+		// pick the operator of a random account that is not onboarded yet
+		for _, i := range r.Perm(len(accs)) {
+			operatorAddress := sdk.ValAddress(accs[i].Address).String()
+			exists, err := k.Validator.Has(ctx, operatorAddress)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to check validator"), nil, err
+			}
+			if !exists {
+				msg.Index = operatorAddress
+				msg.MemberId = operatorAddress
+				msg.OperatorAddress = operatorAddress
+				break
+			}
+		}
+		if msg.OperatorAddress == "" {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "all accounts are onboarded"), nil, nil
+		}
+
+		council := councilMembers(ctx, gk, authority, accs)
+		if len(council) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no council members"), nil, nil
+		}
+		proposer := council[r.Intn(len(council))]
+
+		proposal, err := group.NewMsgSubmitProposal(authority, []string{proposer.Address.String()}, []sdk.Msg{msg}, "", group.Exec_EXEC_UNSPECIFIED, "", "")
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to build proposal"), nil, err
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             proposal,
+			Context:         ctx,
+			SimAccount:      proposer,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		opMsg, _, err := simulation.GenAndDeliverTxWithRandFees(txCtx)
+		if err != nil {
+			return opMsg, nil, err
+		}
+
+		proposalID, err := lastProposalID(ctx, gk, authority)
+		if err != nil {
+			return opMsg, nil, err
+		}
+		futureOps := make([]simtypes.FutureOperation, 0, len(council))
+		for _, voter := range council {
+			futureOps = append(futureOps, simtypes.FutureOperation{
+				BlockHeight: int(ctx.BlockHeight()) + 1,
+				Op:          simulateCouncilVote(ak, bk, gk, txGen, proposalID, voter),
+			})
+		}
+
+		return opMsg, futureOps, nil
+	}
+}
+
+// simulateCouncilVote votes yes on a council proposal.
+func simulateCouncilVote(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	gk types.GroupKeeper,
+	txGen client.TxConfig,
+	proposalID uint64,
+	voter simtypes.Account,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &group.MsgVote{
+			ProposalId: proposalID,
+			Voter:      voter.Address.String(),
+			Option:     group.VOTE_OPTION_YES,
+			Exec:       group.Exec_EXEC_UNSPECIFIED,
+		}
+
+		res, err := gk.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "proposal not found"), nil, nil
+		}
+		if res.Proposal.Status != group.PROPOSAL_STATUS_SUBMITTED || !ctx.BlockTime().Before(res.Proposal.VotingPeriodEnd) {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "proposal is closed for voting"), nil, nil
+		}
 
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "OnboardValidator simulation not implemented"), nil, nil
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      voter,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// councilMembers returns the simulation accounts that are members of the group
+// behind the authority group policy.
+func councilMembers(ctx sdk.Context, gk types.GroupKeeper, authority string, accs []simtypes.Account) []simtypes.Account {
+	policy, err := gk.GroupPolicyInfo(ctx, &group.QueryGroupPolicyInfoRequest{Address: authority})
+	if err != nil {
+		return nil
+	}
+	members, err := gk.GroupMembers(ctx, &group.QueryGroupMembersRequest{GroupId: policy.Info.GroupId})
+	if err != nil {
+		return nil
+	}
+
+	var council []simtypes.Account
+	for _, member := range members.Members {
+		if acc, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(member.Member.Address)); found {
+			council = append(council, acc)
+		}
+	}
+
+	return council
+}
+
+// lastProposalID returns the id of the latest proposal of the authority group
+// policy.
+func lastProposalID(ctx sdk.Context, gk types.GroupKeeper, authority string) (uint64, error) {
+	var id uint64
+	pageReq := &query.PageRequest{}
+	for {
+		res, err := gk.ProposalsByGroupPolicy(ctx, &group.QueryProposalsByGroupPolicyRequest{Address: authority, Pagination: pageReq})
+		if err != nil {
+			return 0, err
+		}
+		for _, p := range res.Proposals {
+			id = max(id, p.Id)
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return id, nil
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
 	}
 }
@@ -7,6 +7,10 @@ import (
 )
 
 func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgOffboardValidator{},
+	)
+
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgOnboardValidator{},
 	)
@@ -10,4 +10,8 @@ import (
 var (
 	ErrInvalidSigner    = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
 	ErrInvalidValidator = errors.Register(ModuleName, 1101, "invalid validator")
+
+	ErrInsufficientTrustDeposit = errors.Register(ModuleName, 1102, "insufficient trust deposit")
+	ErrValidatorNotFound        = errors.Register(ModuleName, 1103, "validator not found")
+	ErrValidatorBonded          = errors.Register(ModuleName, 1104, "validator is bonded")
 )
@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/core/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // GroupKeeper is an alias for the group keeper interface
@@ -24,6 +25,20 @@ type BankKeeper interface {
 	// Methods imported from bank should be defined here
 }
 
+// StakingKeeper defines the expected interface for the Staking module.
+type StakingKeeper interface {
+	GetBondedValidatorsByPower(ctx context.Context) ([]stakingtypes.Validator, error)
+	GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
+	Jail(ctx context.Context, consAddr sdk.ConsAddress) error
+	BondDenom(ctx context.Context) (string, error) // only used for simulation
+}
+
+// TdKeeper defines the expected interface for the trust deposit module.
+type TdKeeper interface {
+	LockTrustDeposit(ctx context.Context, account sdk.AccAddress, amount uint64) error
+	UnlockTrustDeposit(ctx context.Context, account sdk.AccAddress, amount uint64) error
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
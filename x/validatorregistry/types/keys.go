@@ -13,7 +13,22 @@ const (
 	// It should be synced with the gov module's name if it is ever changed.
 	// See: https://github.com/cosmos/cosmos-sdk/blob/v0.52.0-beta.2/x/gov/types/keys.go#L9
 	GovModuleName = "gov"
+
+	// ValidatorStatusActive is the status of registry entries allowed to be
+	// bonded validators. Statuses are compared case-insensitively.
+	ValidatorStatusActive = "active"
+	// ValidatorStatusInactive is the status of registry entries not allowed
+	// to be bonded validators.
+	ValidatorStatusInactive = "inactive"
+	// ValidatorStatusSuspended is the status of registry entries suspended by
+	// the council.
+	ValidatorStatusSuspended = "suspended"
 )
 
+// ValidatorStatuses returns the statuses a registry entry can be onboarded with.
+func ValidatorStatuses() []string {
+	return []string{ValidatorStatusActive, ValidatorStatusInactive, ValidatorStatusSuspended}
+}
+
 // ParamsKey is the prefix to retrieve all Params
 var ParamsKey = collections.NewPrefix("p_validatorregistry")
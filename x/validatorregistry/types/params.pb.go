@@ -26,6 +26,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// min_trust_deposit is the trust deposit, in uvna, the operator account of a
+	// validator must have in x/td to be onboarded. It is locked until the
+	// validator is offboarded. If zero, no trust deposit is required.
+	MinTrustDeposit uint64 `protobuf:"varint,1,opt,name=min_trust_deposit,json=minTrustDeposit,proto3" json:"min_trust_deposit,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,6 +65,13 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMinTrustDeposit() uint64 {
+	if m != nil {
+		return m.MinTrustDeposit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "veranatest.validatorregistry.v1.Params")
 }
@@ -70,18 +81,20 @@ func init() {
 }
 
 var fileDescriptor_ef4d4644097ac5d1 = []byte{
-	// 173 bytes of a gzipped FileDescriptorProto
+	// 208 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x29, 0x4b, 0x2d, 0x4a,
 	0xcc, 0x4b, 0x2c, 0x49, 0x2d, 0x2e, 0xd1, 0x2f, 0x4b, 0xcc, 0xc9, 0x4c, 0x49, 0x2c, 0xc9, 0x2f,
 	0x2a, 0x4a, 0x4d, 0xcf, 0x2c, 0x2e, 0x29, 0xaa, 0xd4, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a,
 	0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x47, 0xa8, 0xd6, 0xc3, 0x50, 0xad,
 	0x57, 0x66, 0x28, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0x7a, 0xa4, 0x44,
-	0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x22, 0xaa, 0x64, 0xc1, 0xc5, 0x16, 0x00,
-	0x36, 0xd9, 0x4a, 0xef, 0xc5, 0x02, 0x79, 0xc6, 0xae, 0xe7, 0x1b, 0xb4, 0x54, 0x91, 0x9c, 0x52,
-	0x81, 0xc5, 0x31, 0x10, 0xf5, 0x4e, 0x76, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8,
-	0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7,
-	0x10, 0xa5, 0x42, 0xc0, 0x80, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x03, 0x8c, 0x01,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xfb, 0x6b, 0xab, 0xae, 0xfa, 0x00, 0x00, 0x00,
+	0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x22, 0xaa, 0x94, 0xc2, 0xc5, 0x16, 0x00,
+	0x36, 0x59, 0x48, 0x8b, 0x4b, 0x30, 0x37, 0x33, 0x2f, 0xbe, 0xa4, 0xa8, 0xb4, 0xb8, 0x24, 0x3e,
+	0x25, 0xb5, 0x20, 0xbf, 0x38, 0xb3, 0x44, 0x82, 0x51, 0x81, 0x51, 0x83, 0x25, 0x88, 0x3f, 0x37,
+	0x33, 0x2f, 0x04, 0x24, 0xee, 0x02, 0x11, 0xb6, 0xd2, 0x7b, 0xb1, 0x40, 0x9e, 0xb1, 0xeb, 0xf9,
+	0x06, 0x2d, 0x55, 0x24, 0x67, 0x57, 0x60, 0x71, 0x38, 0xc4, 0x6c, 0x27, 0xbb, 0x13, 0x8f, 0xe4,
+	0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f,
+	0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x21, 0x60, 0x40, 0x49, 0x65, 0x41, 0x6a, 0x71,
+	0x12, 0x1b, 0xd8, 0xb1, 0xc6, 0x80, 0x01, 0x00, 0xc8, 0xfa, 0x66, 0x58, 0x26, 0x01, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -103,6 +116,9 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.MinTrustDeposit != that1.MinTrustDeposit {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -125,6 +141,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinTrustDeposit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinTrustDeposit))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -145,6 +166,9 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.MinTrustDeposit != 0 {
+		n += 1 + sovParams(uint64(m.MinTrustDeposit))
+	}
 	return n
 }
 
@@ -183,6 +207,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTrustDeposit", wireType)
+			}
+			m.MinTrustDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinTrustDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -302,6 +302,151 @@ func (m *QueryAllValidatorResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryInvariantsRequest is request type for the Query/Invariants RPC method.
+type QueryInvariantsRequest struct {
+}
+
+func (m *QueryInvariantsRequest) Reset()         { *m = QueryInvariantsRequest{} }
+func (m *QueryInvariantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantsRequest) ProtoMessage()    {}
+func (*QueryInvariantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0aeeedf2d2b174e4, []int{6}
+}
+func (m *QueryInvariantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInvariantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantsRequest.Merge(m, src)
+}
+func (m *QueryInvariantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInvariantsRequest proto.InternalMessageInfo
+
+// QueryInvariantsResponse is response type for the Query/Invariants RPC method.
+type QueryInvariantsResponse struct {
+	Invariants []InvariantResult `protobuf:"bytes,1,rep,name=invariants,proto3" json:"invariants"`
+}
+
+func (m *QueryInvariantsResponse) Reset()         { *m = QueryInvariantsResponse{} }
+func (m *QueryInvariantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantsResponse) ProtoMessage()    {}
+func (*QueryInvariantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0aeeedf2d2b174e4, []int{7}
+}
+func (m *QueryInvariantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInvariantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantsResponse.Merge(m, src)
+}
+func (m *QueryInvariantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInvariantsResponse proto.InternalMessageInfo
+
+func (m *QueryInvariantsResponse) GetInvariants() []InvariantResult {
+	if m != nil {
+		return m.Invariants
+	}
+	return nil
+}
+
+// InvariantResult is the result of running an invariant.
+type InvariantResult struct {
+	// route is the crisis route of the invariant.
+	Route  string `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	Broken bool   `protobuf:"varint,2,opt,name=broken,proto3" json:"broken,omitempty"`
+	// message describes the state the invariant checked.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *InvariantResult) Reset()         { *m = InvariantResult{} }
+func (m *InvariantResult) String() string { return proto.CompactTextString(m) }
+func (*InvariantResult) ProtoMessage()    {}
+func (*InvariantResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0aeeedf2d2b174e4, []int{8}
+}
+func (m *InvariantResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantResult.Merge(m, src)
+}
+func (m *InvariantResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantResult proto.InternalMessageInfo
+
+func (m *InvariantResult) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *InvariantResult) GetBroken() bool {
+	if m != nil {
+		return m.Broken
+	}
+	return false
+}
+
+func (m *InvariantResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "veranatest.validatorregistry.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "veranatest.validatorregistry.v1.QueryParamsResponse")
@@ -309,6 +454,9 @@ func init() {
 	proto.RegisterType((*QueryGetValidatorResponse)(nil), "veranatest.validatorregistry.v1.QueryGetValidatorResponse")
 	proto.RegisterType((*QueryAllValidatorRequest)(nil), "veranatest.validatorregistry.v1.QueryAllValidatorRequest")
 	proto.RegisterType((*QueryAllValidatorResponse)(nil), "veranatest.validatorregistry.v1.QueryAllValidatorResponse")
+	proto.RegisterType((*QueryInvariantsRequest)(nil), "veranatest.validatorregistry.v1.QueryInvariantsRequest")
+	proto.RegisterType((*QueryInvariantsResponse)(nil), "veranatest.validatorregistry.v1.QueryInvariantsResponse")
+	proto.RegisterType((*InvariantResult)(nil), "veranatest.validatorregistry.v1.InvariantResult")
 }
 
 func init() {
@@ -316,40 +464,47 @@ func init() {
 }
 
 var fileDescriptor_0aeeedf2d2b174e4 = []byte{
-	// 517 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x31, 0x6f, 0x13, 0x31,
-	0x18, 0x86, 0xe3, 0xd2, 0x46, 0x8a, 0x81, 0x01, 0x93, 0xa1, 0x44, 0xe8, 0x8a, 0x2c, 0x44, 0x21,
-	0x54, 0x36, 0x49, 0xbb, 0xd0, 0x01, 0x89, 0x0c, 0x54, 0x42, 0x08, 0x95, 0x1b, 0x18, 0xd8, 0x1c,
-	0x6a, 0x9d, 0x2c, 0x2e, 0xf6, 0xf5, 0xec, 0x9e, 0x1a, 0x21, 0x16, 0x7e, 0x01, 0x12, 0x7f, 0x81,
-	0x81, 0x0d, 0x06, 0x26, 0x36, 0xb6, 0x8e, 0x95, 0x58, 0x98, 0x10, 0x4a, 0x90, 0xf8, 0x1b, 0xe8,
-	0x6c, 0x37, 0xb9, 0x28, 0x27, 0xdd, 0x15, 0x96, 0xc8, 0xf1, 0x7d, 0xef, 0xf7, 0x3d, 0xef, 0xf9,
-	0xf5, 0xc1, 0xbb, 0x19, 0x4f, 0x99, 0x64, 0x86, 0x6b, 0x43, 0x33, 0x16, 0x8b, 0x03, 0x66, 0x54,
-	0x9a, 0xf2, 0x48, 0x68, 0x93, 0x8e, 0x69, 0xd6, 0xa3, 0x87, 0x47, 0x3c, 0x1d, 0x93, 0x24, 0x55,
-	0x46, 0xa1, 0x8d, 0x79, 0x31, 0x59, 0x2a, 0x26, 0x59, 0xaf, 0x73, 0x85, 0x8d, 0x84, 0x54, 0xd4,
-	0xfe, 0x3a, 0x4d, 0xa7, 0xfb, 0x52, 0xe9, 0x91, 0xd2, 0x74, 0xc8, 0x34, 0x77, 0xcd, 0x68, 0xd6,
-	0x1b, 0x72, 0xc3, 0x7a, 0x34, 0x61, 0x91, 0x90, 0xcc, 0x08, 0x25, 0x7d, 0x6d, 0x3b, 0x52, 0x91,
-	0xb2, 0x4b, 0x9a, 0xaf, 0xfc, 0xee, 0xf5, 0x48, 0xa9, 0x28, 0xe6, 0x94, 0x25, 0x82, 0x32, 0x29,
-	0x95, 0xb1, 0x12, 0xed, 0x9f, 0x6e, 0x55, 0x19, 0x48, 0x58, 0xca, 0x46, 0x67, 0xd5, 0xb4, 0xaa,
-	0x7a, 0xb6, 0xe9, 0x04, 0xb8, 0x0d, 0xd1, 0xb3, 0x1c, 0x7a, 0xdf, 0x76, 0x09, 0xf9, 0xe1, 0x11,
-	0xd7, 0x06, 0x33, 0x78, 0x75, 0x61, 0x57, 0x27, 0x4a, 0x6a, 0x8e, 0x1e, 0xc3, 0xa6, 0x9b, 0xb6,
-	0x0e, 0x6e, 0x80, 0xdb, 0x17, 0xfb, 0x9b, 0xa4, 0xe2, 0x85, 0x11, 0xd7, 0x60, 0xd0, 0x3a, 0xf9,
-	0xb9, 0xd1, 0xf8, 0xf8, 0xe7, 0x73, 0x17, 0x84, 0xbe, 0x03, 0xbe, 0x07, 0xd7, 0xed, 0x88, 0x3d,
-	0x6e, 0x9e, 0x9f, 0x29, 0xfd, 0x78, 0xd4, 0x86, 0x6b, 0x42, 0x1e, 0xf0, 0x63, 0x3b, 0xa6, 0x15,
-	0xba, 0x3f, 0xf8, 0x15, 0xbc, 0x56, 0xa2, 0xf0, 0x68, 0x4f, 0x61, 0x6b, 0x06, 0xe0, 0xe9, 0xba,
-	0x95, 0x74, 0xb3, 0x36, 0x83, 0xd5, 0x1c, 0x30, 0x9c, 0xb7, 0xc0, 0x43, 0x8f, 0xf7, 0x30, 0x8e,
-	0x97, 0xf0, 0x1e, 0x41, 0x38, 0x3f, 0x5a, 0x3f, 0xec, 0x16, 0x71, 0x39, 0x20, 0x79, 0x0e, 0x88,
-	0x0b, 0x95, 0xcf, 0x01, 0xd9, 0x67, 0x11, 0xf7, 0xda, 0xb0, 0xa0, 0xc4, 0x5f, 0x80, 0x77, 0xb4,
-	0x38, 0xa4, 0xdc, 0xd1, 0x85, 0xff, 0x74, 0x84, 0xf6, 0x16, 0xa8, 0x57, 0xfc, 0x01, 0x56, 0x51,
-	0x3b, 0x98, 0x22, 0x76, 0xff, 0xd3, 0x2a, 0x5c, 0xb3, 0xd8, 0xe8, 0x03, 0x80, 0x4d, 0x77, 0xc2,
-	0x68, 0xbb, 0x12, 0x6d, 0x39, 0x66, 0x9d, 0x9d, 0xf3, 0x89, 0x1c, 0x0b, 0xa6, 0x6f, 0xbf, 0xff,
-	0x7e, 0xbf, 0x72, 0x07, 0x6d, 0xd2, 0x7a, 0x57, 0x03, 0x7d, 0x03, 0xf0, 0x52, 0x31, 0x34, 0xe8,
-	0x7e, 0xbd, 0xb9, 0x25, 0xd1, 0xec, 0xec, 0xfe, 0x8b, 0xd4, 0x83, 0xef, 0x5a, 0xf0, 0x1d, 0xd4,
-	0xaf, 0x7f, 0x4b, 0xe9, 0x6b, 0x9b, 0xfd, 0x37, 0xe8, 0x2b, 0x80, 0x97, 0x9f, 0x08, 0x7d, 0x7e,
-	0x13, 0x25, 0x01, 0xae, 0x6b, 0xa2, 0x2c, 0x96, 0xb8, 0x6f, 0x4d, 0x6c, 0xa1, 0x6e, 0x7d, 0x13,
-	0x83, 0x07, 0x27, 0x93, 0x00, 0x9c, 0x4e, 0x02, 0xf0, 0x6b, 0x12, 0x80, 0x77, 0xd3, 0xa0, 0x71,
-	0x3a, 0x0d, 0x1a, 0x3f, 0xa6, 0x41, 0xe3, 0xc5, 0xcd, 0x42, 0x93, 0xe3, 0x92, 0x36, 0x66, 0x9c,
-	0x70, 0x3d, 0x6c, 0xda, 0x6f, 0xd5, 0xf6, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x67, 0x65, 0x88,
-	0x80, 0xcd, 0x05, 0x00, 0x00,
+	// 629 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xc1, 0x6b, 0x13, 0x4f,
+	0x14, 0xc7, 0xb3, 0xed, 0xaf, 0xf9, 0x99, 0xa7, 0x22, 0x8e, 0xa1, 0xa6, 0x8b, 0x6c, 0x65, 0x11,
+	0xab, 0x69, 0xdd, 0x69, 0x92, 0x82, 0x9a, 0x83, 0x60, 0x0e, 0x16, 0x45, 0xa4, 0xee, 0xa1, 0xa0,
+	0xb7, 0x89, 0x1d, 0x96, 0xa5, 0xc9, 0xce, 0x66, 0x67, 0xb2, 0x34, 0x88, 0x17, 0xff, 0x02, 0xc1,
+	0x7f, 0xc1, 0x83, 0x47, 0x0f, 0x3d, 0x79, 0xf3, 0xd6, 0x63, 0xc1, 0x8b, 0x27, 0x91, 0x44, 0xf4,
+	0xdf, 0x90, 0xcc, 0x4c, 0x36, 0x89, 0x59, 0xd8, 0xa4, 0x5e, 0xc2, 0xce, 0xdb, 0xf7, 0x7d, 0xef,
+	0xf3, 0xdd, 0x7d, 0x6f, 0x03, 0x9b, 0x31, 0x8d, 0x48, 0x40, 0x04, 0xe5, 0x02, 0xc7, 0xa4, 0xe5,
+	0x1f, 0x10, 0xc1, 0xa2, 0x88, 0x7a, 0x3e, 0x17, 0x51, 0x0f, 0xc7, 0x15, 0xdc, 0xe9, 0xd2, 0xa8,
+	0xe7, 0x84, 0x11, 0x13, 0x0c, 0xad, 0x8f, 0x93, 0x9d, 0x99, 0x64, 0x27, 0xae, 0x98, 0x97, 0x49,
+	0xdb, 0x0f, 0x18, 0x96, 0xbf, 0x4a, 0x63, 0x96, 0x5f, 0x31, 0xde, 0x66, 0x1c, 0x37, 0x09, 0xa7,
+	0xaa, 0x18, 0x8e, 0x2b, 0x4d, 0x2a, 0x48, 0x05, 0x87, 0xc4, 0xf3, 0x03, 0x22, 0x7c, 0x16, 0xe8,
+	0xdc, 0xa2, 0xc7, 0x3c, 0x26, 0x2f, 0xf1, 0xf0, 0x4a, 0x47, 0xaf, 0x79, 0x8c, 0x79, 0x2d, 0x8a,
+	0x49, 0xe8, 0x63, 0x12, 0x04, 0x4c, 0x48, 0x09, 0xd7, 0x77, 0xb7, 0xb2, 0x0c, 0x84, 0x24, 0x22,
+	0xed, 0x51, 0x36, 0xce, 0xca, 0x4e, 0x82, 0x4a, 0x60, 0x17, 0x01, 0x3d, 0x1f, 0x42, 0xef, 0xc9,
+	0x2a, 0x2e, 0xed, 0x74, 0x29, 0x17, 0x36, 0x81, 0x2b, 0x53, 0x51, 0x1e, 0xb2, 0x80, 0x53, 0xf4,
+	0x04, 0xf2, 0xaa, 0x5b, 0xc9, 0xb8, 0x6e, 0xdc, 0x3a, 0x5f, 0xdd, 0x70, 0x32, 0x1e, 0x98, 0xa3,
+	0x0a, 0x34, 0x0a, 0x27, 0xdf, 0xd7, 0x73, 0x1f, 0x7f, 0x7f, 0x2a, 0x1b, 0xae, 0xae, 0x60, 0x6f,
+	0x43, 0x49, 0xb6, 0xd8, 0xa5, 0x62, 0x7f, 0xa4, 0xd4, 0xed, 0x51, 0x11, 0x56, 0xfc, 0xe0, 0x80,
+	0x1e, 0xc9, 0x36, 0x05, 0x57, 0x1d, 0xec, 0x43, 0x58, 0x4b, 0x51, 0x68, 0xb4, 0x67, 0x50, 0x48,
+	0x00, 0x34, 0x5d, 0x39, 0x93, 0x2e, 0x29, 0xd3, 0xf8, 0x6f, 0x08, 0xe8, 0x8e, 0x4b, 0xd8, 0x4d,
+	0x8d, 0xf7, 0xb0, 0xd5, 0x9a, 0xc1, 0x7b, 0x04, 0x30, 0x7e, 0xb5, 0xba, 0xd9, 0x4d, 0x47, 0xcd,
+	0x81, 0x33, 0x9c, 0x03, 0x47, 0x0d, 0x95, 0x9e, 0x03, 0x67, 0x8f, 0x78, 0x54, 0x6b, 0xdd, 0x09,
+	0xa5, 0x7d, 0x6c, 0xc0, 0x5a, 0x4a, 0x93, 0x74, 0x47, 0xcb, 0xff, 0xe8, 0x08, 0xed, 0x4e, 0x51,
+	0x2f, 0xe9, 0x17, 0x98, 0x45, 0xad, 0x60, 0xa6, 0xb0, 0x4b, 0xb0, 0x2a, 0xa9, 0x1f, 0x07, 0x31,
+	0x89, 0x7c, 0x12, 0x88, 0x64, 0x6c, 0x3a, 0x70, 0x75, 0xe6, 0x8e, 0x76, 0xb3, 0x0f, 0xe0, 0x27,
+	0x51, 0x6d, 0x67, 0x3b, 0xd3, 0x4e, 0x52, 0xc8, 0xa5, 0xbc, 0xdb, 0x12, 0xda, 0xd4, 0x44, 0x25,
+	0xfb, 0x05, 0x5c, 0xfa, 0x2b, 0x69, 0x38, 0x3d, 0x11, 0xeb, 0x0a, 0x3a, 0x9a, 0x1e, 0x79, 0x40,
+	0xab, 0x90, 0x6f, 0x46, 0xec, 0x90, 0x2a, 0xeb, 0xe7, 0x5c, 0x7d, 0x42, 0x25, 0xf8, 0xbf, 0x4d,
+	0x39, 0x27, 0x1e, 0x2d, 0x2d, 0xcb, 0xfc, 0xd1, 0xb1, 0xfa, 0x6b, 0x05, 0x56, 0xa4, 0x1d, 0xf4,
+	0xc1, 0x80, 0xbc, 0x9a, 0x64, 0x54, 0xcb, 0x64, 0x9e, 0x5d, 0x27, 0x73, 0x67, 0x31, 0x91, 0x7a,
+	0x64, 0x36, 0x7e, 0xfb, 0xf5, 0xe7, 0xfb, 0xa5, 0xdb, 0x68, 0x03, 0xcf, 0xf7, 0x09, 0x40, 0x5f,
+	0x0c, 0xb8, 0x30, 0xb9, 0x1c, 0xe8, 0xfe, 0x7c, 0x7d, 0x53, 0x56, 0xd0, 0xac, 0x9f, 0x45, 0xaa,
+	0xc1, 0xeb, 0x12, 0x7c, 0x07, 0x55, 0xe7, 0xff, 0x1a, 0xe1, 0xd7, 0x72, 0xc7, 0xdf, 0xa0, 0xcf,
+	0x06, 0x5c, 0x7c, 0xea, 0xf3, 0xc5, 0x4d, 0xa4, 0x2c, 0xaa, 0x59, 0x3f, 0x8b, 0x54, 0x9b, 0xa8,
+	0x4a, 0x13, 0x5b, 0xa8, 0x3c, 0xbf, 0x09, 0x74, 0x6c, 0x00, 0x8c, 0x67, 0x1f, 0xdd, 0x9d, 0xaf,
+	0xfd, 0xcc, 0x1e, 0x99, 0xf7, 0x16, 0x17, 0x6a, 0xea, 0x9a, 0xa4, 0xbe, 0x83, 0x36, 0x33, 0xa9,
+	0xc7, 0x3b, 0xd4, 0x78, 0x70, 0xd2, 0xb7, 0x8c, 0xd3, 0xbe, 0x65, 0xfc, 0xe8, 0x5b, 0xc6, 0xbb,
+	0x81, 0x95, 0x3b, 0x1d, 0x58, 0xb9, 0x6f, 0x03, 0x2b, 0xf7, 0xf2, 0xc6, 0x44, 0x95, 0xa3, 0x94,
+	0x3a, 0xa2, 0x17, 0x52, 0xde, 0xcc, 0xcb, 0xbf, 0x92, 0xda, 0x9f, 0x01, 0x00, 0x21, 0x90, 0x87,
+	0xc0, 0x6c, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -370,6 +525,9 @@ type QueryClient interface {
 	GetValidator(ctx context.Context, in *QueryGetValidatorRequest, opts ...grpc.CallOption) (*QueryGetValidatorResponse, error)
 	// ListValidator defines the ListValidator RPC.
 	ListValidator(ctx context.Context, in *QueryAllValidatorRequest, opts ...grpc.CallOption) (*QueryAllValidatorResponse, error)
+	// Invariants runs the validatorregistry module invariants and reports which
+	// are broken.
+	Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error)
 }
 
 type queryClient struct {
@@ -407,6 +565,15 @@ func (c *queryClient) ListValidator(ctx context.Context, in *QueryAllValidatorRe
 	return out, nil
 }
 
+func (c *queryClient) Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error) {
+	out := new(QueryInvariantsResponse)
+	err := c.cc.Invoke(ctx, "/veranatest.validatorregistry.v1.Query/Invariants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -415,6 +582,9 @@ type QueryServer interface {
 	GetValidator(context.Context, *QueryGetValidatorRequest) (*QueryGetValidatorResponse, error)
 	// ListValidator defines the ListValidator RPC.
 	ListValidator(context.Context, *QueryAllValidatorRequest) (*QueryAllValidatorResponse, error)
+	// Invariants runs the validatorregistry module invariants and reports which
+	// are broken.
+	Invariants(context.Context, *QueryInvariantsRequest) (*QueryInvariantsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -430,6 +600,9 @@ func (*UnimplementedQueryServer) GetValidator(ctx context.Context, req *QueryGet
 func (*UnimplementedQueryServer) ListValidator(ctx context.Context, req *QueryAllValidatorRequest) (*QueryAllValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListValidator not implemented")
 }
+func (*UnimplementedQueryServer) Invariants(ctx context.Context, req *QueryInvariantsRequest) (*QueryInvariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invariants not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -489,6 +662,24 @@ func _Query_ListValidator_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Invariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInvariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Invariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/veranatest.validatorregistry.v1.Query/Invariants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Invariants(ctx, req.(*QueryInvariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "veranatest.validatorregistry.v1.Query",
@@ -506,6 +697,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListValidator",
 			Handler:    _Query_ListValidator_Handler,
 		},
+		{
+			MethodName: "Invariants",
+			Handler:    _Query_Invariants_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "veranatest/validatorregistry/v1/query.proto",
@@ -714,6 +909,113 @@ func (m *QueryAllValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryInvariantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInvariantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Invariants) > 0 {
+		for iNdEx := len(m.Invariants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Invariants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InvariantResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -801,6 +1103,50 @@ func (m *QueryAllValidatorResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryInvariantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInvariantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Invariants) > 0 {
+		for _, e := range m.Invariants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InvariantResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Broken {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1311,6 +1657,274 @@ func (m *QueryAllValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryInvariantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvariantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvariantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInvariantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvariantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvariantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invariants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Invariants = append(m.Invariants, InvariantResult{})
+			if err := m.Invariants[len(m.Invariants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InvariantResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvariantResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvariantResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broken = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -254,11 +254,103 @@ func (m *MsgOnboardValidatorResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgOnboardValidatorResponse proto.InternalMessageInfo
 
+// MsgOffboardValidator defines the MsgOffboardValidator message.
+type MsgOffboardValidator struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Index   string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *MsgOffboardValidator) Reset()         { *m = MsgOffboardValidator{} }
+func (m *MsgOffboardValidator) String() string { return proto.CompactTextString(m) }
+func (*MsgOffboardValidator) ProtoMessage()    {}
+func (*MsgOffboardValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce6176a0605f7114, []int{4}
+}
+func (m *MsgOffboardValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOffboardValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOffboardValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgOffboardValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOffboardValidator.Merge(m, src)
+}
+func (m *MsgOffboardValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOffboardValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOffboardValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOffboardValidator proto.InternalMessageInfo
+
+func (m *MsgOffboardValidator) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgOffboardValidator) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+// MsgOffboardValidatorResponse defines the MsgOffboardValidatorResponse message.
+type MsgOffboardValidatorResponse struct {
+}
+
+func (m *MsgOffboardValidatorResponse) Reset()         { *m = MsgOffboardValidatorResponse{} }
+func (m *MsgOffboardValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOffboardValidatorResponse) ProtoMessage()    {}
+func (*MsgOffboardValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce6176a0605f7114, []int{5}
+}
+func (m *MsgOffboardValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOffboardValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOffboardValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgOffboardValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOffboardValidatorResponse.Merge(m, src)
+}
+func (m *MsgOffboardValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOffboardValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOffboardValidatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOffboardValidatorResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "veranatest.validatorregistry.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "veranatest.validatorregistry.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgOnboardValidator)(nil), "veranatest.validatorregistry.v1.MsgOnboardValidator")
 	proto.RegisterType((*MsgOnboardValidatorResponse)(nil), "veranatest.validatorregistry.v1.MsgOnboardValidatorResponse")
+	proto.RegisterType((*MsgOffboardValidator)(nil), "veranatest.validatorregistry.v1.MsgOffboardValidator")
+	proto.RegisterType((*MsgOffboardValidatorResponse)(nil), "veranatest.validatorregistry.v1.MsgOffboardValidatorResponse")
 }
 
 func init() {
@@ -266,41 +358,43 @@ func init() {
 }
 
 var fileDescriptor_ce6176a0605f7114 = []byte{
-	// 533 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xc1, 0x8b, 0xd3, 0x4e,
-	0x14, 0xee, 0x74, 0xb7, 0xed, 0x66, 0x7e, 0x0b, 0xed, 0x6f, 0x2c, 0x6e, 0x9a, 0xc5, 0x6c, 0x29,
-	0x82, 0xb5, 0x68, 0xe2, 0x56, 0x11, 0x59, 0x44, 0xd8, 0x8a, 0x07, 0x85, 0xe2, 0x12, 0xd1, 0x83,
-	0x97, 0x30, 0xed, 0x0c, 0x31, 0x68, 0x32, 0x61, 0x66, 0x5a, 0x5a, 0x4f, 0xe2, 0xc1, 0x83, 0x27,
-	0xfd, 0x2f, 0xbc, 0x08, 0x3d, 0xf8, 0x47, 0xec, 0x71, 0xf1, 0xb4, 0x27, 0x91, 0xf6, 0xd0, 0x7f,
-	0x43, 0x92, 0x49, 0xac, 0x76, 0x17, 0xa2, 0x5e, 0xc2, 0xbc, 0xef, 0xbd, 0xef, 0x7b, 0xf3, 0xbd,
-	0x79, 0x81, 0xed, 0x31, 0xe5, 0x38, 0xc4, 0x92, 0x0a, 0x69, 0x8f, 0xf1, 0x2b, 0x9f, 0x60, 0xc9,
-	0x38, 0xa7, 0x9e, 0x2f, 0x24, 0x9f, 0xda, 0xe3, 0x7d, 0x5b, 0x4e, 0xac, 0x88, 0x33, 0xc9, 0xd0,
-	0xde, 0xaa, 0xd2, 0x3a, 0x53, 0x69, 0x8d, 0xf7, 0x8d, 0xff, 0x71, 0xe0, 0x87, 0xcc, 0x4e, 0xbe,
-	0x8a, 0x63, 0xec, 0x0c, 0x99, 0x08, 0x98, 0xb0, 0x03, 0xe1, 0xc5, 0x5a, 0x81, 0xf0, 0xd2, 0x44,
-	0x43, 0x25, 0xdc, 0x24, 0xb2, 0x55, 0x90, 0xa6, 0xea, 0x1e, 0xf3, 0x98, 0xc2, 0xe3, 0x53, 0x8a,
-	0x5e, 0xcb, 0xbb, 0x67, 0x84, 0x39, 0x0e, 0x52, 0x8d, 0xd6, 0x29, 0x80, 0xd5, 0xbe, 0xf0, 0x9e,
-	0x46, 0x04, 0x4b, 0x7a, 0x94, 0x64, 0xd0, 0x6d, 0xa8, 0xe1, 0x91, 0x7c, 0xc1, 0xb8, 0x2f, 0xa7,
-	0x3a, 0x68, 0x82, 0xb6, 0xd6, 0xd3, 0xbf, 0x7e, 0xb9, 0x5e, 0x4f, 0x9b, 0x1f, 0x12, 0xc2, 0xa9,
-	0x10, 0x4f, 0x24, 0xf7, 0x43, 0xcf, 0x59, 0x95, 0xa2, 0x47, 0xb0, 0xac, 0xb4, 0xf5, 0x62, 0x13,
-	0xb4, 0xff, 0xeb, 0x5e, 0xb1, 0x72, 0x06, 0x61, 0xa9, 0x86, 0x3d, 0xed, 0xf8, 0xdb, 0x5e, 0xe1,
-	0xd3, 0x72, 0xd6, 0x01, 0x4e, 0xaa, 0x70, 0x70, 0xf8, 0x76, 0x39, 0xeb, 0xac, 0xb4, 0xdf, 0x2f,
-	0x67, 0x9d, 0x5f, 0xd4, 0xec, 0xc9, 0x39, 0xd6, 0xd6, 0x6c, 0xb4, 0x1a, 0x70, 0x67, 0x0d, 0x72,
-	0xa8, 0x88, 0x58, 0x28, 0x68, 0xeb, 0x73, 0x11, 0x5e, 0xe8, 0x0b, 0xef, 0x71, 0x38, 0x60, 0x98,
-	0x93, 0x67, 0x99, 0x14, 0xea, 0xc2, 0xca, 0x90, 0xd3, 0xf8, 0x98, 0xeb, 0x3b, 0x2b, 0x44, 0x75,
-	0x58, 0xf2, 0x43, 0x42, 0x27, 0x89, 0x69, 0xcd, 0x51, 0x01, 0xda, 0x85, 0x5a, 0x40, 0x83, 0x01,
-	0xe5, 0xae, 0x4f, 0xf4, 0x8d, 0x24, 0xb3, 0xa5, 0x80, 0x87, 0x04, 0xdd, 0x87, 0x35, 0x16, 0x51,
-	0x1e, 0xd3, 0x5d, 0xac, 0x54, 0xf5, 0xcd, 0x9c, 0x7e, 0xd5, 0x8c, 0x91, 0xc2, 0xe8, 0x2a, 0xac,
-	0x0d, 0x63, 0x33, 0xa1, 0x18, 0x09, 0x37, 0x1a, 0x0d, 0x5e, 0xd2, 0xa9, 0x5e, 0x4a, 0x1a, 0x55,
-	0x7f, 0xe2, 0x47, 0x09, 0x8c, 0x2e, 0xc2, 0xb2, 0x90, 0x58, 0x8e, 0x84, 0x5e, 0x4e, 0x0a, 0xd2,
-	0x08, 0x35, 0xe0, 0x96, 0xa4, 0x3c, 0x70, 0x69, 0x48, 0xf4, 0x4a, 0x13, 0xb4, 0x37, 0x9d, 0x4a,
-	0x1c, 0x3f, 0x08, 0xc9, 0xc1, 0x76, 0x3c, 0xff, 0xcc, 0x63, 0xeb, 0x12, 0xdc, 0x3d, 0x67, 0x5c,
-	0xd9, 0x38, 0xbb, 0x1f, 0x8b, 0x70, 0xa3, 0x2f, 0x3c, 0xf4, 0x1a, 0x6e, 0xff, 0xb6, 0x48, 0x37,
-	0x72, 0x17, 0x60, 0xed, 0x81, 0x8c, 0x3b, 0x7f, 0xcb, 0xc8, 0xee, 0x80, 0xde, 0x01, 0x58, 0x3b,
-	0xf3, 0x9e, 0xb7, 0xfe, 0x44, 0x6e, 0x9d, 0x65, 0xdc, 0xfd, 0x17, 0x56, 0x76, 0x11, 0xa3, 0xf4,
-	0x26, 0x5e, 0xe4, 0xde, 0xbd, 0xe3, 0xb9, 0x09, 0x4e, 0xe6, 0x26, 0xf8, 0x3e, 0x37, 0xc1, 0x87,
-	0x85, 0x59, 0x38, 0x59, 0x98, 0x85, 0xd3, 0x85, 0x59, 0x78, 0x7e, 0x39, 0x67, 0x8f, 0xe5, 0x34,
-	0xa2, 0x62, 0x50, 0x4e, 0xfe, 0xcf, 0x9b, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x42, 0xc5, 0xb5,
-	0xbf, 0x77, 0x04, 0x00, 0x00,
+	// 568 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4d, 0x6b, 0x13, 0x41,
+	0x18, 0xce, 0xb4, 0x4d, 0xd2, 0x8c, 0x85, 0xa4, 0x63, 0xb0, 0x9b, 0xad, 0x6e, 0x43, 0x10, 0x8c,
+	0x41, 0xb3, 0x36, 0x7e, 0x20, 0x45, 0x85, 0x46, 0x3c, 0x28, 0x04, 0x4b, 0x44, 0x0f, 0x5e, 0x96,
+	0x49, 0x76, 0xba, 0x2e, 0xba, 0x33, 0xcb, 0xcc, 0x24, 0x24, 0x9e, 0xc4, 0x83, 0xa0, 0x27, 0xff,
+	0x80, 0x77, 0x2f, 0x42, 0x0e, 0xfe, 0x88, 0x1e, 0x8b, 0xa7, 0x9e, 0x44, 0x92, 0x43, 0xfe, 0x86,
+	0xec, 0x57, 0xa2, 0x9b, 0xc0, 0xd6, 0x82, 0x97, 0x65, 0xde, 0xe7, 0x7d, 0x9f, 0xe7, 0xfd, 0xd8,
+	0x77, 0x06, 0x56, 0xfb, 0x84, 0x63, 0x8a, 0x25, 0x11, 0x52, 0xef, 0xe3, 0x37, 0xb6, 0x89, 0x25,
+	0xe3, 0x9c, 0x58, 0xb6, 0x90, 0x7c, 0xa8, 0xf7, 0x77, 0x75, 0x39, 0xa8, 0xbb, 0x9c, 0x49, 0x86,
+	0x76, 0xe6, 0x91, 0xf5, 0x85, 0xc8, 0x7a, 0x7f, 0x57, 0xdd, 0xc4, 0x8e, 0x4d, 0x99, 0xee, 0x7f,
+	0x03, 0x8e, 0xba, 0xd5, 0x65, 0xc2, 0x61, 0x42, 0x77, 0x84, 0xe5, 0x69, 0x39, 0xc2, 0x0a, 0x1d,
+	0xa5, 0xc0, 0x61, 0xf8, 0x96, 0x1e, 0x18, 0xa1, 0xab, 0x68, 0x31, 0x8b, 0x05, 0xb8, 0x77, 0x0a,
+	0xd1, 0x6b, 0x49, 0x75, 0xba, 0x98, 0x63, 0x27, 0xd4, 0xa8, 0x9c, 0x00, 0x98, 0x6f, 0x09, 0xeb,
+	0xb9, 0x6b, 0x62, 0x49, 0x0e, 0x7c, 0x0f, 0xba, 0x03, 0x73, 0xb8, 0x27, 0x5f, 0x31, 0x6e, 0xcb,
+	0xa1, 0x02, 0xca, 0xa0, 0x9a, 0x6b, 0x2a, 0x3f, 0xbe, 0x5f, 0x2f, 0x86, 0xc9, 0xf7, 0x4d, 0x93,
+	0x13, 0x21, 0x9e, 0x49, 0x6e, 0x53, 0xab, 0x3d, 0x0f, 0x45, 0x4f, 0x60, 0x26, 0xd0, 0x56, 0x56,
+	0xca, 0xa0, 0x7a, 0xae, 0x71, 0xa5, 0x9e, 0x30, 0x88, 0x7a, 0x90, 0xb0, 0x99, 0x3b, 0xfa, 0xb9,
+	0x93, 0xfa, 0x3a, 0x1d, 0xd5, 0x40, 0x3b, 0x54, 0xd8, 0xdb, 0x7f, 0x3f, 0x1d, 0xd5, 0xe6, 0xda,
+	0x9f, 0xa6, 0xa3, 0xda, 0x1f, 0x6a, 0xfa, 0x60, 0x49, 0x6b, 0xb1, 0x36, 0x2a, 0x25, 0xb8, 0x15,
+	0x83, 0xda, 0x44, 0xb8, 0x8c, 0x0a, 0x52, 0xf9, 0xb6, 0x02, 0xcf, 0xb7, 0x84, 0xf5, 0x94, 0x76,
+	0x18, 0xe6, 0xe6, 0x8b, 0x48, 0x0a, 0x35, 0x60, 0xb6, 0xcb, 0x89, 0x77, 0x4c, 0xec, 0x3b, 0x0a,
+	0x44, 0x45, 0x98, 0xb6, 0xa9, 0x49, 0x06, 0x7e, 0xd3, 0xb9, 0x76, 0x60, 0xa0, 0x6d, 0x98, 0x73,
+	0x88, 0xd3, 0x21, 0xdc, 0xb0, 0x4d, 0x65, 0xd5, 0xf7, 0xac, 0x07, 0xc0, 0x63, 0x13, 0x3d, 0x84,
+	0x05, 0xe6, 0x12, 0xee, 0xd1, 0x0d, 0x1c, 0xa8, 0x2a, 0x6b, 0x09, 0xf9, 0xf2, 0x11, 0x23, 0x84,
+	0xd1, 0x55, 0x58, 0xe8, 0x7a, 0xcd, 0x50, 0xd1, 0x13, 0x86, 0xdb, 0xeb, 0xbc, 0x26, 0x43, 0x25,
+	0xed, 0x27, 0xca, 0xcf, 0xf0, 0x03, 0x1f, 0x46, 0x17, 0x60, 0x46, 0x48, 0x2c, 0x7b, 0x42, 0xc9,
+	0xf8, 0x01, 0xa1, 0x85, 0x4a, 0x70, 0x5d, 0x12, 0xee, 0x18, 0x84, 0x9a, 0x4a, 0xb6, 0x0c, 0xaa,
+	0x6b, 0xed, 0xac, 0x67, 0x3f, 0xa2, 0xe6, 0xde, 0x86, 0x37, 0xff, 0xa8, 0xc7, 0xca, 0x25, 0xb8,
+	0xbd, 0x64, 0x5c, 0xb3, 0x71, 0x52, 0x58, 0xf4, 0xdc, 0x87, 0x87, 0xff, 0x6b, 0x9c, 0xb1, 0x72,
+	0x34, 0x78, 0x71, 0x59, 0xbe, 0xa8, 0x9e, 0xc6, 0x97, 0x55, 0xb8, 0xda, 0x12, 0x16, 0x7a, 0x0b,
+	0x37, 0xfe, 0x5a, 0xec, 0x1b, 0x89, 0x0b, 0x19, 0x5b, 0x18, 0xf5, 0xee, 0xbf, 0x32, 0xa2, 0x1a,
+	0xd0, 0x07, 0x00, 0x0b, 0x0b, 0xfb, 0x75, 0xeb, 0x34, 0x72, 0x71, 0x96, 0x7a, 0xef, 0x2c, 0xac,
+	0x59, 0x21, 0x1f, 0x01, 0xdc, 0x5c, 0xfc, 0x35, 0xb7, 0x4f, 0xa5, 0x19, 0xa7, 0xa9, 0xf7, 0xcf,
+	0x44, 0x8b, 0x6a, 0x51, 0xd3, 0xef, 0xbc, 0x4b, 0xde, 0x7c, 0x70, 0x34, 0xd6, 0xc0, 0xf1, 0x58,
+	0x03, 0xbf, 0xc6, 0x1a, 0xf8, 0x3c, 0xd1, 0x52, 0xc7, 0x13, 0x2d, 0x75, 0x32, 0xd1, 0x52, 0x2f,
+	0x2f, 0x27, 0xdc, 0x71, 0x39, 0x74, 0x89, 0xe8, 0x64, 0xfc, 0xb7, 0xeb, 0xe6, 0xef, 0x01, 0x00,
+	0x04, 0x41, 0x6c, 0xea, 0x93, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -320,6 +414,9 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// OnboardValidator defines the OnboardValidator RPC.
 	OnboardValidator(ctx context.Context, in *MsgOnboardValidator, opts ...grpc.CallOption) (*MsgOnboardValidatorResponse, error)
+	// OffboardValidator removes a validator from the registry and releases the
+	// trust deposit locked when it was onboarded.
+	OffboardValidator(ctx context.Context, in *MsgOffboardValidator, opts ...grpc.CallOption) (*MsgOffboardValidatorResponse, error)
 }
 
 type msgClient struct {
@@ -348,6 +445,15 @@ func (c *msgClient) OnboardValidator(ctx context.Context, in *MsgOnboardValidato
 	return out, nil
 }
 
+func (c *msgClient) OffboardValidator(ctx context.Context, in *MsgOffboardValidator, opts ...grpc.CallOption) (*MsgOffboardValidatorResponse, error) {
+	out := new(MsgOffboardValidatorResponse)
+	err := c.cc.Invoke(ctx, "/veranatest.validatorregistry.v1.Msg/OffboardValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -355,6 +461,9 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// OnboardValidator defines the OnboardValidator RPC.
 	OnboardValidator(context.Context, *MsgOnboardValidator) (*MsgOnboardValidatorResponse, error)
+	// OffboardValidator removes a validator from the registry and releases the
+	// trust deposit locked when it was onboarded.
+	OffboardValidator(context.Context, *MsgOffboardValidator) (*MsgOffboardValidatorResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -367,6 +476,9 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) OnboardValidator(ctx context.Context, req *MsgOnboardValidator) (*MsgOnboardValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OnboardValidator not implemented")
 }
+func (*UnimplementedMsgServer) OffboardValidator(ctx context.Context, req *MsgOffboardValidator) (*MsgOffboardValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OffboardValidator not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -408,6 +520,24 @@ func _Msg_OnboardValidator_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_OffboardValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOffboardValidator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OffboardValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/veranatest.validatorregistry.v1.Msg/OffboardValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OffboardValidator(ctx, req.(*MsgOffboardValidator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "veranatest.validatorregistry.v1.Msg",
@@ -421,6 +551,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "OnboardValidator",
 			Handler:    _Msg_OnboardValidator_Handler,
 		},
+		{
+			MethodName: "OffboardValidator",
+			Handler:    _Msg_OffboardValidator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "veranatest/validatorregistry/v1/tx.proto",
@@ -582,6 +716,66 @@ func (m *MsgOnboardValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgOffboardValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOffboardValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOffboardValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOffboardValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOffboardValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOffboardValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -662,6 +856,32 @@ func (m *MsgOnboardValidatorResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgOffboardValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOffboardValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1144,6 +1364,170 @@ func (m *MsgOnboardValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgOffboardValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOffboardValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOffboardValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOffboardValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOffboardValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOffboardValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
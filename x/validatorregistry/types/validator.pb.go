@@ -30,6 +30,9 @@ type Validator struct {
 	ConsensusPubkey string `protobuf:"bytes,4,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
 	Status          string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
 	TermEnd         uint64 `protobuf:"varint,6,opt,name=term_end,json=termEnd,proto3" json:"term_end,omitempty"`
+	// locked_trust_deposit is the trust deposit of the operator account locked
+	// when the validator was onboarded, released when it is offboarded.
+	LockedTrustDeposit uint64 `protobuf:"varint,7,opt,name=locked_trust_deposit,json=lockedTrustDeposit,proto3" json:"locked_trust_deposit,omitempty"`
 }
 
 func (m *Validator) Reset()         { *m = Validator{} }
@@ -107,6 +110,13 @@ func (m *Validator) GetTermEnd() uint64 {
 	return 0
 }
 
+func (m *Validator) GetLockedTrustDeposit() uint64 {
+	if m != nil {
+		return m.LockedTrustDeposit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Validator)(nil), "veranatest.validatorregistry.v1.Validator")
 }
@@ -116,24 +126,25 @@ func init() {
 }
 
 var fileDescriptor_b18ecebc079435b2 = []byte{
-	// 261 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x31, 0x4b, 0xc3, 0x40,
-	0x1c, 0xc5, 0x73, 0xda, 0xc6, 0xe6, 0x16, 0xe5, 0x10, 0x39, 0x11, 0xce, 0x22, 0x0e, 0x75, 0x49,
-	0x28, 0xee, 0x82, 0x82, 0x83, 0x9b, 0x74, 0x70, 0x70, 0x09, 0x17, 0xef, 0x8f, 0x04, 0xcd, 0x5d,
-	0xb8, 0xff, 0x25, 0x34, 0xdf, 0xc2, 0x6f, 0xa5, 0x63, 0x47, 0x47, 0x49, 0xbe, 0x88, 0xe4, 0x62,
-	0xad, 0x60, 0xc7, 0xf7, 0x7b, 0xbf, 0xb7, 0x3c, 0x9a, 0xd4, 0x60, 0xa5, 0x96, 0x0e, 0xd0, 0x25,
-	0xb5, 0x7c, 0xcd, 0x95, 0x74, 0xc6, 0x5a, 0x78, 0xce, 0xd1, 0xd9, 0x26, 0xa9, 0xe7, 0x1b, 0x18,
-	0x97, 0xd6, 0x38, 0xc3, 0x4e, 0x37, 0x83, 0xf8, 0xdf, 0x20, 0xae, 0xe7, 0x67, 0xef, 0x84, 0x46,
-	0x0f, 0xeb, 0x82, 0x1d, 0xd2, 0x71, 0xae, 0x15, 0x2c, 0x39, 0x99, 0x92, 0x59, 0xb4, 0x18, 0x02,
-	0x3b, 0xa1, 0x51, 0x01, 0x45, 0x06, 0x36, 0xcd, 0x15, 0xdf, 0xf1, 0xcd, 0x64, 0x00, 0x77, 0x8a,
-	0x5d, 0xd0, 0x03, 0x53, 0x82, 0xed, 0xe7, 0xa9, 0x54, 0xca, 0x02, 0x22, 0xdf, 0xf5, 0xce, 0xfe,
-	0x9a, 0x5f, 0x0f, 0xb8, 0x57, 0x9f, 0x8c, 0x46, 0xd0, 0x58, 0x61, 0x5a, 0x56, 0xd9, 0x0b, 0x34,
-	0x7c, 0x34, 0xa8, 0xbf, 0xfc, 0xde, 0x63, 0x76, 0x44, 0x43, 0x74, 0xd2, 0x55, 0xc8, 0xc7, 0x5e,
-	0xf8, 0x49, 0xec, 0x98, 0x4e, 0x1c, 0xd8, 0x22, 0x05, 0xad, 0x78, 0x38, 0x25, 0xb3, 0xd1, 0x62,
-	0xaf, 0xcf, 0xb7, 0x5a, 0xdd, 0x5c, 0x7d, 0xb4, 0x82, 0xac, 0x5a, 0x41, 0xbe, 0x5a, 0x41, 0xde,
-	0x3a, 0x11, 0xac, 0x3a, 0x11, 0x7c, 0x76, 0x22, 0x78, 0x3c, 0xff, 0xf3, 0xda, 0x72, 0xcb, 0x6f,
-	0xae, 0x29, 0x01, 0xb3, 0xd0, 0x3f, 0x76, 0xf9, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x6d, 0x06, 0x00,
-	0x9d, 0x64, 0x01, 0x00, 0x00,
+	// 287 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xbf, 0x4a, 0x33, 0x41,
+	0x14, 0xc5, 0x33, 0xdf, 0x97, 0xbf, 0xd3, 0x28, 0x43, 0x90, 0x11, 0x61, 0x0d, 0x62, 0x11, 0x9b,
+	0xc4, 0x60, 0x2f, 0x28, 0x5a, 0xd8, 0x49, 0x10, 0x0b, 0x9b, 0x61, 0x92, 0xb9, 0xc8, 0x92, 0x64,
+	0x66, 0x99, 0x7b, 0x77, 0x49, 0xde, 0xc2, 0xc7, 0xb2, 0x4c, 0x69, 0x29, 0xd9, 0xa7, 0xb0, 0x93,
+	0x9d, 0x31, 0x46, 0xd0, 0xf2, 0xfc, 0xce, 0xef, 0x34, 0x87, 0x0f, 0x0b, 0xf0, 0xda, 0x6a, 0x02,
+	0xa4, 0x61, 0xa1, 0xe7, 0xa9, 0xd1, 0xe4, 0xbc, 0x87, 0xe7, 0x14, 0xc9, 0xaf, 0x86, 0xc5, 0x68,
+	0x07, 0x07, 0x99, 0x77, 0xe4, 0xc4, 0xf1, 0x6e, 0x30, 0xf8, 0x35, 0x18, 0x14, 0xa3, 0x93, 0x0f,
+	0xc6, 0x3b, 0x8f, 0xdb, 0x42, 0x74, 0x79, 0x23, 0xb5, 0x06, 0x96, 0x92, 0xf5, 0x58, 0xbf, 0x33,
+	0x8e, 0x41, 0x1c, 0xf1, 0xce, 0x02, 0x16, 0x13, 0xf0, 0x2a, 0x35, 0xf2, 0x5f, 0x68, 0xda, 0x11,
+	0xdc, 0x19, 0x71, 0xc6, 0xf7, 0x5d, 0x06, 0xbe, 0x9a, 0x2b, 0x6d, 0x8c, 0x07, 0x44, 0xf9, 0x3f,
+	0x38, 0x7b, 0x5b, 0x7e, 0x15, 0x71, 0xa5, 0x4e, 0x9d, 0x45, 0xb0, 0x98, 0xa3, 0xca, 0xf2, 0xc9,
+	0x0c, 0x56, 0xb2, 0x1e, 0xd5, 0x6f, 0x7e, 0x1f, 0xb0, 0x38, 0xe0, 0x4d, 0x24, 0x4d, 0x39, 0xca,
+	0x46, 0x10, 0xbe, 0x92, 0x38, 0xe4, 0x6d, 0x02, 0xbf, 0x50, 0x60, 0x8d, 0x6c, 0xf6, 0x58, 0xbf,
+	0x3e, 0x6e, 0x55, 0xf9, 0xd6, 0x1a, 0x71, 0xce, 0xbb, 0x73, 0x37, 0x9d, 0x81, 0x51, 0xe4, 0x73,
+	0x24, 0x65, 0x20, 0x73, 0x98, 0x92, 0x6c, 0x05, 0x4d, 0xc4, 0xee, 0xa1, 0xaa, 0x6e, 0x62, 0x73,
+	0x7d, 0xf9, 0xba, 0x49, 0xd8, 0x7a, 0x93, 0xb0, 0xf7, 0x4d, 0xc2, 0x5e, 0xca, 0xa4, 0xb6, 0x2e,
+	0x93, 0xda, 0x5b, 0x99, 0xd4, 0x9e, 0x4e, 0x7f, 0xfc, 0xbc, 0xfc, 0xe3, 0x69, 0x5a, 0x65, 0x80,
+	0x93, 0x66, 0xf8, 0xf8, 0xe2, 0x73, 0x00, 0xff, 0x76, 0xa4, 0xd7, 0x96, 0x01, 0x00, 0x00,
 }
 
 func (m *Validator) Marshal() (dAtA []byte, err error) {
@@ -156,6 +167,11 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LockedTrustDeposit != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.LockedTrustDeposit))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.TermEnd != 0 {
 		i = encodeVarintValidator(dAtA, i, uint64(m.TermEnd))
 		i--
@@ -239,6 +255,9 @@ func (m *Validator) Size() (n int) {
 	if m.TermEnd != 0 {
 		n += 1 + sovValidator(uint64(m.TermEnd))
 	}
+	if m.LockedTrustDeposit != 0 {
+		n += 1 + sovValidator(uint64(m.LockedTrustDeposit))
+	}
 	return n
 }
 
@@ -456,6 +475,25 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedTrustDeposit", wireType)
+			}
+			m.LockedTrustDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockedTrustDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipValidator(dAtA[iNdEx:])